@@ -0,0 +1,68 @@
+package main
+
+// Agent 목록 조회
+// - 서버 ListAgents RPC 로 알려진 Agent 목록을 받아 Overview 그리드 자리표시자를 미리 그릴 수 있게 함
+// - 연결 전이거나 호출 실패 시 빈 목록 반환
+// - 응답의 이름/태그로 Agent 메타데이터 캐시(metadata.go)도 함께 갱신
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 단건 RPC 호출 제한 시간
+	UNARY_RPC_TIMEOUT_MS = 3000
+)
+
+// agentSummary는 프론트로 전달하는 Agent 요약 정보입니다.
+type agentSummary struct {
+	AgentID            string            `json:"agentId"`
+	LastFrameTimestamp int64             `json:"lastFrameTimestamp"`
+	LastSeen           int64             `json:"lastSeen"`
+	Offline            bool              `json:"offline"`
+	Name               string            `json:"name"`
+	Tags               map[string]string `json:"tags"`
+	Online             bool              `json:"online"`
+	LastError          string            `json:"lastError"`
+	FPS                float64           `json:"fps"`
+}
+
+// GetAgents 서버가 알고 있는 Agent 목록을 반환합니다. 연결 전이면 빈 목록입니다.
+func (a *App) GetAgents() []agentSummary {
+	agents := make([]agentSummary, 0)
+	client := a.client()
+	if client == nil {
+		return agents
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	resp, err := client.ListAgents(ctx, &proto.ListAgentsRequest{AdminId: a.adminID})
+	if err != nil {
+		log.Printf("[Admin][RPC] ListAgents 실패: %v", err)
+		return agents
+	}
+	meta := make(map[string]agentMetadata)
+	for _, agent := range resp.GetAgents() {
+		agents = append(agents, agentSummary{
+			AgentID:            agent.GetAgentId(),
+			LastFrameTimestamp: agent.GetLastFrameTimestamp(),
+			LastSeen:           agent.GetLastSeen(),
+			Offline:            agent.GetOffline(),
+			Name:               agent.GetName(),
+			Tags:               agent.GetTags(),
+			Online:             agent.GetOnline(),
+			LastError:          agent.GetLastError(),
+			FPS:                agent.GetFps(),
+		})
+		if agent.GetName() != "" || len(agent.GetTags()) > 0 {
+			meta[agent.GetAgentId()] = agentMetadata{Name: agent.GetName(), Tags: agent.GetTags()}
+		}
+	}
+	// 목록 조회 결과로 메타데이터 캐시도 함께 갱신
+	a.setAgentMetadata(meta)
+	return agents
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"admin/internal/server/servertest"
+)
+
+func TestGetAgentsFromServer(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	pushFrame(h, "agent-2", "img")
+	pushFrame(h, "agent-1", "img")
+	app, _ := startTestApp(t, h)
+
+	waitConnected(t, app)
+	agents := app.GetAgents()
+	if len(agents) != 2 || agents[0].AgentID != "agent-1" || agents[1].AgentID != "agent-2" {
+		t.Fatalf("GetAgents = %+v, want [agent-1 agent-2]", agents)
+	}
+	if !agents[0].Online || agents[0].LastSeen == 0 {
+		t.Fatalf("agent-1 요약 = %+v", agents[0])
+	}
+}
+
+func TestGetAgentsBeforeConnect(t *testing.T) {
+	app, _ := newTestApp()
+	if agents := app.GetAgents(); agents == nil || len(agents) != 0 {
+		t.Fatalf("연결 전 GetAgents = %v, want 빈 목록", agents)
+	}
+}
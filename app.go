@@ -7,127 +7,559 @@ package main
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"log"
+	"net"
+	"os"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"admin/proto"
 
-	"github.com/wailsapp/wails/v2/pkg/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	// gRPC 서버 주소 (추후 환경변수/설정화 가능)
+	// 기본 gRPC 서버 주소 (ENV_GRPC_SERVER_ADDRESS 또는 SetServerAddress 로 변경 가능)
 	GRPC_SERVER_ADDRESS = "localhost:50051"
-	// 연결 재시도 간격
-	RECONNECT_INTERVAL_MS = 3000
+	// 초기 서버 주소를 지정하는 환경변수 이름
+	ENV_GRPC_SERVER_ADDRESS = "ADMIN_GRPC_ADDR"
 	// 이벤트 이름 상수
 	EVENT_OVERVIEW_FRAME = "overviewFrame"
+	// 처음 보는 Agent 의 첫 프레임 수신 시 (overviewFrame 보다 먼저) 발행하는 이벤트 이름
+	EVENT_AGENT_DISCOVERED = "agentDiscovered"
+	// Overview 구독 ID (재연결 시 서버에 남은 이전 구독을 교체하도록 고정값 사용)
+	OVERVIEW_SUBSCRIPTION_ID = "main"
+	// 종료 시 스트림 수신 고루틴 종료를 기다리는 최대 시간
+	STREAM_SHUTDOWN_TIMEOUT_MS = 2000
+	// 에이전트 오프라인 신호용 특수 타임스탬프 값 (서버와 동일)
+	OFFLINE_TIMESTAMP = 0
+	// 프레임 상태 문자열 (프론트 배지 구분용)
+	FRAME_STATUS_LIVE    = "live"
+	FRAME_STATUS_OFFLINE = "offline"
+	FRAME_STATUS_ERROR   = "error"
+	// 서버 keepalive(heartbeat) 신호용 특수 타임스탬프 값 및 이벤트 타입 (서버와 동일)
+	HEARTBEAT_TIMESTAMP  = -2
+	HEARTBEAT_EVENT_TYPE = "heartbeat"
 )
 
 // frameSnapshot는 최신 프레임 캐시 구조입니다.
 type frameSnapshot struct {
 	AgentID   string `json:"agentId"`
 	ImageBase string `json:"imageBase64"`
+	// raw 모드(SetRawFrameEmit)로 수신한 이미지 바이트 (base64 모드에서는 비어 있음, 프론트는 ImageURL 로 받음)
+	ImageBytes []byte `json:"-"`
+	// raw 모드 이미지 주소 (frameImageHandler 가 ImageBytes 를 그대로 응답)
+	ImageURL string `json:"imageUrl,omitempty"`
+	// 이미지 전달 방식 (FRAME_ENCODING_BASE64 / FRAME_ENCODING_RAW)
+	Encoding  string `json:"encoding"`
 	IsPreview bool   `json:"isPreview"`
 	Timestamp int64  `json:"timestamp"`
+	// 오프라인 신호 프레임으로 갱신된 스냅샷 여부
+	Offline bool `json:"offline"`
+	// 클라이언트 수신 시각 (ms, 로컬 시계 기준 - 캐시 정리 판단용)
+	ReceivedAt int64 `json:"receivedAt"`
+	// 프레임을 보낸 서버 주소
+	Server string `json:"server"`
+	// 프레임 상태 (live / offline / error) 및 오류 사유
+	Status        string `json:"status"`
+	StatusMessage string `json:"statusMessage"`
+	// 서버에 등록된 표시 이름/태그 (조회 시 메타데이터 캐시에서 채움)
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+// isOfflineFrame 오프라인 신호 프레임인지 판단합니다.
+// Offline 플래그 우선, 구버전 서버 호환을 위해 빈 이미지 + OFFLINE_TIMESTAMP 도 인정합니다.
+func isOfflineFrame(frame *proto.FrameData) bool {
+	if frame.GetOffline() || frame.GetStatus() == proto.FrameStatus_FRAME_STATUS_OFFLINE {
+		return true
+	}
+	return frame.GetTimestamp() == OFFLINE_TIMESTAMP && len(frame.GetImageData()) == 0
+}
+
+// frameStatusName 프론트 배지 표시용 프레임 상태 문자열을 반환합니다. (live / offline / error)
+func frameStatusName(frame *proto.FrameData) string {
+	if isOfflineFrame(frame) {
+		return FRAME_STATUS_OFFLINE
+	}
+	if frame.GetStatus() == proto.FrameStatus_FRAME_STATUS_ERROR {
+		return FRAME_STATUS_ERROR
+	}
+	return FRAME_STATUS_LIVE
+}
+
+// frameFormatName 프레임 이미지 형식을 프론트 전달용 문자열로 변환합니다. (jpeg / png / webp / unknown, 미지정은 "")
+func frameFormatName(frame *proto.FrameData) string {
+	switch frame.GetFormat() {
+	case proto.ImageFormat_IMAGE_FORMAT_JPEG:
+		return "jpeg"
+	case proto.ImageFormat_IMAGE_FORMAT_PNG:
+		return "png"
+	case proto.ImageFormat_IMAGE_FORMAT_WEBP:
+		return "webp"
+	case proto.ImageFormat_IMAGE_FORMAT_UNKNOWN:
+		return "unknown"
+	}
+	return ""
+}
+
+// frameFileExt 프레임 이미지 형식에 맞는 파일 확장자를 반환합니다.
+// 형식을 알리지 않는 이전 서버의 프레임(미지정)은 JPEG 로 간주하고, 판별 불가 형식은 ".bin" 입니다.
+func frameFileExt(frame *proto.FrameData) string {
+	switch frame.GetFormat() {
+	case proto.ImageFormat_IMAGE_FORMAT_PNG:
+		return ".png"
+	case proto.ImageFormat_IMAGE_FORMAT_WEBP:
+		return ".webp"
+	case proto.ImageFormat_IMAGE_FORMAT_UNKNOWN:
+		return ".bin"
+	}
+	return ".jpg"
+}
+
+// isHeartbeatFrame 스트림 유지용 heartbeat 프레임인지 판단합니다. (렌더링 대상 아님)
+func isHeartbeatFrame(frame *proto.FrameData) bool {
+	return frame.GetTimestamp() == HEARTBEAT_TIMESTAMP && len(frame.GetImageData()) == 0
 }
 
 // App 구조체 (Wails 바인딩)
 type App struct {
-	ctx          context.Context
+	ctx context.Context
+	// 현재 연결/클라이언트/연결 단위 취소 함수 (bootstrap 고루틴이 교체하고 바인딩 메서드·shutdown 이 읽으므로 connMu 로 보호)
+	connMu       sync.Mutex
 	conn         *grpc.ClientConn
 	adminClient  proto.AdminServiceClient
 	cancel       context.CancelFunc
 	framesMu     sync.RWMutex
 	latestFrames map[string]*frameSnapshot
+	frameStats   map[string]*agentFrameStats
+	// 연결 설정 보호용 Mutex (serverAddr, tls, token, status)
+	mu         sync.Mutex
+	serverAddr string
+	tls        tlsSettings
+	token      string
+	status     connectionStatus
+	// 주소 변경 등으로 재시도 대기 없이 즉시 재연결할 때 사용하는 신호
+	reconnectCh chan struct{}
+	// 재연결 백오프, 최대 연속 재시도 횟수(0 은 무제한) 및 재시도 포기 여부
+	backoff    *reconnectBackoff
+	maxRetries atomic.Int64
+	gaveUp     atomic.Bool
+	// 수명 관리 (startup/shutdown 중복 호출 방지, stop 은 a.ctx 취소)
+	started atomic.Bool
+	stopped atomic.Bool
+	stop    context.CancelFunc
+	// 이 App 인스턴스의 Admin 식별자 (모든 구독에 공통 사용)
+	adminID string
+	// 생성 옵션으로 주입한 dialer(nil 이면 TCP 로 serverAddr 에 연결) 및 추가 연결 옵션
+	dialer           func(context.Context, string) (net.Conn, error)
+	extraDialOptions []grpc.DialOption
+	// WithEventEmitter 로 주입한 이벤트 발행 함수 (nil 이면 Wails 런타임)
+	emitter func(name string, data any)
+	// 초기 연결 제한 시간 (0 이하이면 비동기 연결)
+	dialTimeout time.Duration
+	// Overview 스트림 전용 취소 함수와 꺼짐 여부 (연결 cancel 과 별도 관리)
+	overviewMu     sync.Mutex
+	overviewCancel context.CancelFunc
+	overviewOff    bool
+	overviewFocus  string // 원본 해상도로 받을 포커스 Agent (SetOverviewFocus)
+	overviewPaused bool   // 서버 전달 일시정지 여부 (PauseOverview)
+	overviewToggle chan struct{}
+	// Overview 묶음(FrameBatch) 수신 사용 여부 (서버 미지원 시 자동 해제)
+	overviewBatch atomic.Bool
+	// clientStats 집계 주기 동안 수신한 프레임 수
+	framesReceived frameCounter
+	// Overview 프레임 인코딩 워커 대기열
+	encoder *frameEncoder
+	// Overview 프레임을 base64 대신 raw 바이트로 발행할지 여부
+	rawFrameEmit atomic.Bool
+	// Overview 이벤트 발행 속도 제한
+	emitThrottle *emitThrottle
+	// 이벤트 이름 접두사
+	eventPrefix *eventPrefix
+	// 오프라인 신호 유예 시간(ms) 및 Agent 별 대기 타이머 (framesMu 로 보호)
+	offlineGraceMs atomic.Int64
+	offlineTimers  map[string]*time.Timer
+	// AddServer 로 추가한 서버 연결 (주소 -> 연결)
+	serversMu sync.Mutex
+	servers   map[string]*serverConnection
+	// Agent 별 Detail 스트림 (detailWanted: 사용자가 열어 둔 구독, 재연결 시 복구 대상)
+	detailMu      sync.Mutex
+	detailStreams map[string]*streamHandle
+	detailWanted  map[string]struct{}
+	detailSince   map[string]int64 // 재구독 시 since_timestamp (끊긴 스트림의 마지막 프레임 타임스탬프)
+	// Agent 별 Events 스트림 (eventsWanted: 재연결 시 복구 대상)
+	eventsMu     sync.Mutex
+	eventStreams map[string]*streamHandle
+	eventsWanted map[string]struct{}
+	// ListAgents 로 받은 Agent 메타데이터 캐시
+	metaMu    sync.RWMutex
+	agentMeta map[string]agentMetadata
+	// Agent 별 녹화 상태
+	recordMu   sync.Mutex
+	recordings map[string]*recording
+}
+
+// NewApp App 생성자 (opts 로 연결 방식 등 기본값 변경)
+func NewApp(opts ...AppOption) *App {
+	addr := GRPC_SERVER_ADDRESS
+	if env := os.Getenv(ENV_GRPC_SERVER_ADDRESS); env != "" {
+		if err := validateServerAddress(env); err != nil {
+			log.Printf("[Admin][BOOT] %s 값 무시: %v", ENV_GRPC_SERVER_ADDRESS, err)
+		} else {
+			addr = env
+		}
+	}
+	adminID := os.Getenv(ENV_ADMIN_ID)
+	if adminID == "" {
+		adminID = fmt.Sprintf("admin-%d", time.Now().UnixNano())
+	}
+	a := &App{
+		latestFrames:   make(map[string]*frameSnapshot),
+		frameStats:     make(map[string]*agentFrameStats),
+		serverAddr:     addr,
+		tls:            tlsSettingsFromEnv(),
+		token:          os.Getenv(ENV_GRPC_TOKEN),
+		reconnectCh:    make(chan struct{}, 1),
+		overviewToggle: make(chan struct{}, 1),
+		adminID:        adminID,
+		detailStreams:  make(map[string]*streamHandle),
+		detailWanted:   make(map[string]struct{}),
+		detailSince:    make(map[string]int64),
+		eventStreams:   make(map[string]*streamHandle),
+		eventsWanted:   make(map[string]struct{}),
+		recordings:     make(map[string]*recording),
+		emitThrottle:   newEmitThrottle(OVERVIEW_MAX_EMITS_PER_SEC),
+		encoder:        newFrameEncoder(OVERVIEW_ENCODE_WORKERS),
+		eventPrefix:    newEventPrefix(),
+		servers:        make(map[string]*serverConnection),
+		offlineTimers:  make(map[string]*time.Timer),
+	}
+	minDelay, maxDelay, maxRetries := reconnectPolicyFromEnv()
+	a.backoff = newReconnectBackoff(minDelay, maxDelay)
+	a.maxRetries.Store(int64(maxRetries))
+	a.offlineGraceMs.Store(OFFLINE_GRACE_MS)
+	a.overviewBatch.Store(overviewBatchFromEnv())
+	a.dialTimeout = dialTimeoutFromEnv()
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// validateServerAddress 서버 주소가 host:port 형식인지 검사합니다.
+func validateServerAddress(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid server address %q: %w", addr, err)
+	}
+	if host == "" || port == "" {
+		return fmt.Errorf("invalid server address %q: host and port required", addr)
+	}
+	return nil
 }
 
-// NewApp App 생성자
-func NewApp() *App {
-	return &App{latestFrames: make(map[string]*frameSnapshot)}
+// serverAddress 현재 설정된 서버 주소를 반환합니다.
+func (a *App) serverAddress() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.serverAddr
 }
 
-// startup Wails 앱 시작 훅
+// SetServerAddress 서버 주소를 변경하고 현재 스트림을 끊어 새 주소로 재연결합니다.
+// 형식이 잘못된 주소는 에러로 반환되어 프론트에서 표시할 수 있습니다.
+func (a *App) SetServerAddress(addr string) error {
+	if err := validateServerAddress(addr); err != nil {
+		return err
+	}
+	a.mu.Lock()
+	if a.serverAddr == addr {
+		a.mu.Unlock()
+		return nil
+	}
+	a.serverAddr = addr
+	a.mu.Unlock()
+
+	log.Printf("[Admin][BOOT] 서버 주소 변경: %s", addr)
+	// 재시도를 포기한 상태였다면 새 주소로 연결 루프를 다시 시작
+	a.Reconnect()
+	return nil
+}
+
+// requestReconnect 현재 스트림을 끊고 백오프 대기 없이 즉시 재연결하도록 신호를 보냅니다.
+func (a *App) requestReconnect() {
+	select {
+	case a.reconnectCh <- struct{}{}:
+	default:
+	}
+	a.cancelConn()
+}
+
+// GetServerAddress 현재 설정된 서버 주소를 반환합니다.
+func (a *App) GetServerAddress() string {
+	return a.serverAddress()
+}
+
+// startup Wails 앱 시작 훅 (OnStartup) - 두 번째 호출부터는 무시합니다.
 func (a *App) startup(ctx context.Context) {
-	a.ctx = ctx
+	if !a.started.CompareAndSwap(false, true) {
+		log.Printf("[Admin][BOOT] startup 중복 호출 무시")
+		return
+	}
+	// Wails context 값(EventsEmit 용)을 유지하면서 shutdown 시 모든 루프를 멈출 수 있도록 취소 가능하게 감쌈
+	a.ctx, a.stop = context.WithCancel(ctx)
 	go a.bootstrapLoop()
+	go a.pruneLoop()
+	go a.frameStatsLoop()
+	go a.overviewEmitLoop()
+	go a.latencyLoop()
+	go a.metadataLoop()
+	go a.clientStatsLoop()
+	a.startEncodeWorkers()
 }
 
 // bootstrapLoop 서버 연결 및 재시도 루프를 수행합니다.
 func (a *App) bootstrapLoop() {
 	for {
-		if err := a.connectAndSubscribe(); err != nil {
-			log.Printf("[Admin][BOOT] 연결/구독 실패: %v", err)
-			time.Sleep(RECONNECT_INTERVAL_MS * time.Millisecond)
-			continue
+		started := time.Now()
+		a.setConnectionStatus(CONNECTION_STATE_CONNECTING, nil)
+		err := a.connectAndSubscribe()
+		if a.ctx.Err() != nil {
+			log.Printf("[Admin][BOOT] 종료 - 재연결 루프 중단")
+			return
+		}
+		// 충분히 오래 유지된 구독이었다면 일시적 끊김으로 보고 백오프 초기화
+		if time.Since(started) >= RECONNECT_STABLE_MS*time.Millisecond {
+			a.backoff.reset()
 		}
-		// 정상 종료(스트림 끝) 시 재연결 시도
-		log.Printf("[Admin][BOOT] 스트림 종료 - 재연결 대기")
-		time.Sleep(RECONNECT_INTERVAL_MS * time.Millisecond)
+		a.emitAppError(err)
+		if a.retriesExhausted() {
+			a.giveUp(err)
+			return
+		}
+		delay := a.backoff.next()
+		a.setConnectionStatus(CONNECTION_STATE_DISCONNECTED, err)
+		if err != nil {
+			log.Printf("[Admin][BOOT] 연결/구독 실패: %v (재시도 %s 후)", err, delay)
+		} else {
+			// 정상 종료(스트림 끝) 시 재연결 시도
+			log.Printf("[Admin][BOOT] 스트림 종료 - 재연결 대기 (%s)", delay)
+		}
+		a.waitReconnect(delay)
+	}
+}
+
+// GetBackoffState 현재 재연결 백오프 상태(시도 횟수, 대기 시간)를 반환합니다.
+func (a *App) GetBackoffState() backoffState {
+	return a.backoff.state()
+}
+
+// waitReconnect 재연결 전 대기합니다. 주소 변경 신호가 오면 즉시 반환합니다.
+func (a *App) waitReconnect(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-a.reconnectCh:
+	case <-a.ctx.Done():
 	}
 }
 
 // connectAndSubscribe gRPC 연결 후 Overview 구독을 시작합니다.
 func (a *App) connectAndSubscribe() error {
 	// 기존 연결 정리
-	if a.conn != nil {
-		_ = a.conn.Close()
+	a.closeConn()
+	addr := a.serverAddress()
+	opts, err := a.dialOptions()
+	if err != nil {
+		return categorizeFatal(ERROR_CATEGORY_DIAL, err)
+	}
+	conn, err := a.dial(addr, opts)
+	if err != nil {
+		return categorize(ERROR_CATEGORY_DIAL, err)
+	}
+	ctx, cancel := context.WithCancel(a.ctx)
+	if !a.setConn(conn, cancel) {
+		// 연결 중에 shutdown 된 경우 새 연결을 남기지 않음
+		cancel()
+		_ = conn.Close()
+		return a.ctx.Err()
 	}
-	conn, err := grpc.Dial(GRPC_SERVER_ADDRESS, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	log.Printf("[Admin][BOOT] 서버 연결 성공: %s", addr)
+	if err := checkHealth(ctx, a.client()); err != nil {
+		return categorize(ERROR_CATEGORY_HEALTH, err)
+	}
+	a.resubscribeStreams()
+	return a.runOverview(ctx)
+}
+
+// dialOptions 현재 TLS/토큰 설정으로 gRPC 연결 옵션을 구성합니다. (추가 서버 연결에도 공통 사용)
+func (a *App) dialOptions() ([]grpc.DialOption, error) {
+	tlsCfg := a.tlsConfig()
+	creds, err := tlsCfg.dialOption()
 	if err != nil {
-		return fmt.Errorf("dial: %w", err)
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+	opts := []grpc.DialOption{creds}
+	a.mu.Lock()
+	token := a.token
+	a.mu.Unlock()
+	if opt, ok := tokenDialOption(token, tlsCfg.Insecure); ok {
+		opts = append(opts, opt)
+	}
+	if opt, ok := compressionDialOption(); ok {
+		opts = append(opts, opt)
+	}
+	if opt, ok := keepaliveDialOption(); ok {
+		opts = append(opts, opt)
+	}
+	if a.dialer != nil {
+		opts = append(opts, grpc.WithContextDialer(a.dialer))
+	}
+	return append(opts, a.extraDialOptions...), nil
+}
+
+// client 현재 연결된 AdminService 클라이언트를 반환합니다. 연결 전이면 nil 입니다.
+func (a *App) client() proto.AdminServiceClient {
+	a.connMu.Lock()
+	defer a.connMu.Unlock()
+	return a.adminClient
+}
+
+// setConn 새 연결과 연결 단위 취소 함수를 등록합니다. 이미 종료 중이면 등록하지 않고 false 를 반환합니다.
+// shutdown 의 closeConn 과 같은 잠금 안에서 종료 여부를 확인하므로 종료 후 연결이 새로 남지 않습니다.
+func (a *App) setConn(conn *grpc.ClientConn, cancel context.CancelFunc) bool {
+	a.connMu.Lock()
+	defer a.connMu.Unlock()
+	if a.stopped.Load() {
+		return false
 	}
 	a.conn = conn
 	a.adminClient = proto.NewAdminServiceClient(conn)
-	ctx, cancel := context.WithCancel(a.ctx)
 	a.cancel = cancel
-	log.Printf("[Admin][BOOT] 서버 연결 성공: %s", GRPC_SERVER_ADDRESS)
-	return a.subscribeOverview(ctx)
+	return true
+}
+
+// cancelConn 현재 연결의 스트림(context)을 취소합니다.
+func (a *App) cancelConn() {
+	a.connMu.Lock()
+	cancel := a.cancel
+	a.connMu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// closeConn 현재 연결을 닫고 비웁니다.
+func (a *App) closeConn() {
+	a.connMu.Lock()
+	conn := a.conn
+	a.conn = nil
+	a.adminClient = nil
+	a.connMu.Unlock()
+	if conn != nil {
+		_ = conn.Close()
+	}
 }
 
 // subscribeOverview Overview 스트림을 구독하여 이벤트로 전파합니다.
 func (a *App) subscribeOverview(ctx context.Context) error {
-	adminID := fmt.Sprintf("admin-%d", time.Now().UnixNano())
-	stream, err := a.adminClient.SubscribeOverview(ctx, &proto.AdminSubscribeRequest{AdminId: adminID})
+	adminID := a.adminID
+	recv, err := a.openOverview(ctx, &proto.AdminSubscribeRequest{AdminId: adminID, SubscriptionId: OVERVIEW_SUBSCRIPTION_ID, FocusAgentId: a.GetOverviewFocus(), Paused: a.IsOverviewPaused()})
 	if err != nil {
-		return fmt.Errorf("subscribe overview: %w", err)
+		return categorize(ERROR_CATEGORY_SUBSCRIBE, err)
 	}
+	server := a.serverAddress()
 	log.Printf("[Admin][STREAM] overview 구독 시작: %s", adminID)
+	a.setConnectionStatus(CONNECTION_STATE_CONNECTED, nil)
 	for {
-		frame, err := stream.Recv()
+		frames, err := recv()
 		if err != nil {
-			return fmt.Errorf("recv: %w", err)
+			// 묶음 RPC 미지원 서버면 단건 구독으로 전환 후 재연결
+			if status.Code(err) == codes.Unimplemented && a.overviewBatch.CompareAndSwap(true, false) {
+				log.Printf("[Admin][STREAM] 서버가 묶음 수신을 지원하지 않음 - 단건 구독으로 전환")
+			}
+			return categorize(recvErrorCategory(err), err)
 		}
-		// 프레임 처리 후 이벤트 발행
-		bs := base64.StdEncoding.EncodeToString(frame.GetImageData())
-		a.storeFrame(frame, bs)
-		runtime.EventsEmit(a.ctx, EVENT_OVERVIEW_FRAME, map[string]any{
-			"agentId":     frame.GetAgentId(),
-			"imageBase64": bs,
-			"isPreview":   frame.GetIsPreview(),
-			"timestamp":   frame.GetTimestamp(),
-		})
+		for _, frame := range frames {
+			if isHeartbeatFrame(frame) {
+				continue
+			}
+			// 인코딩/캐시/발행은 워커에서 처리해 Recv 를 막지 않음
+			a.encoder.put(encodeJob{frame: frame, server: server})
+		}
+	}
+}
+
+// frameEventPayload 프론트로 전달할 프레임 이벤트 페이로드를 만듭니다.
+// offline 은 프론트가 타임스탬프/빈 이미지로 추론하지 않도록 명시적으로 전달합니다.
+func frameEventPayload(frame *proto.FrameData, base64Str string, server string) map[string]any {
+	return map[string]any{
+		"agentId":       frame.GetAgentId(),
+		"imageBase64":   base64Str,
+		"encoding":      FRAME_ENCODING_BASE64,
+		"isPreview":     frame.GetIsPreview(),
+		"timestamp":     frame.GetTimestamp(),
+		"server":        server,
+		"offline":       isOfflineFrame(frame),
+		"status":        frameStatusName(frame),
+		"statusMessage": frame.GetStatusMessage(),
+		"sequence":      frame.GetSequence(),
+		"format":        frameFormatName(frame),
+	}
+}
+
+// newFrameSnapshot 수신 프레임으로 캐시 스냅샷을 만듭니다.
+func newFrameSnapshot(f *proto.FrameData, base64Str string, server string) *frameSnapshot {
+	return &frameSnapshot{
+		AgentID:       f.GetAgentId(),
+		ImageBase:     base64Str,
+		Encoding:      FRAME_ENCODING_BASE64,
+		IsPreview:     f.GetIsPreview(),
+		Timestamp:     f.GetTimestamp(),
+		Offline:       isOfflineFrame(f),
+		ReceivedAt:    time.Now().UnixMilli(),
+		Server:        server,
+		Status:        frameStatusName(f),
+		StatusMessage: f.GetStatusMessage(),
 	}
 }
 
-// storeFrame 최신 프레임을 캐시합니다.
-func (a *App) storeFrame(f *proto.FrameData, base64Str string) {
+// storeFrame 최신 프레임을 캐시하고, 처음 보는 Agent 이면 agentDiscovered 이벤트를 발행합니다.
+func (a *App) storeFrame(f *proto.FrameData, base64Str string, server string) {
+	a.storeSnapshot(f, newFrameSnapshot(f, base64Str, server), server)
+}
+
+// storeSnapshot 미리 만든 스냅샷으로 storeFrame 과 같은 캐시 갱신을 수행합니다. (raw 모드 등)
+func (a *App) storeSnapshot(f *proto.FrameData, snap *frameSnapshot, server string) {
+	agentId := f.GetAgentId()
 	a.framesMu.Lock()
-	a.latestFrames[f.GetAgentId()] = &frameSnapshot{
-		AgentID:   f.GetAgentId(),
-		ImageBase: base64Str,
-		IsPreview: f.GetIsPreview(),
-		Timestamp: f.GetTimestamp(),
+	// 캐시에 없던 Agent 의 실제 프레임이면 최초 발견 (오프라인 신호만 온 Agent 는 제외)
+	_, known := a.latestFrames[agentId]
+	discovered := !known && !isOfflineFrame(f)
+	a.latestFrames[agentId] = snap
+	if !isOfflineFrame(f) {
+		a.framesReceived.add()
+		a.cancelOfflineLocked(agentId)
+		a.recordFrameStats(agentId, f.GetTimestamp())
 	}
 	a.framesMu.Unlock()
+	if discovered {
+		a.emit(EVENT_AGENT_DISCOVERED, map[string]any{
+			"agentId":   agentId,
+			"timestamp": f.GetTimestamp(),
+			"server":    server,
+		})
+	}
 }
 
-// GetLatestFrames 현재까지 수신한 최신 프레임 목록을 반환합니다.
+// GetLatestFrames 현재까지 수신한 최신 프레임 목록을 반환합니다. (AddServer 로 추가한 서버 포함)
+// 호출마다 그리드 타일 순서가 바뀌지 않도록 AgentID, 서버 주소 순으로 정렬합니다.
 func (a *App) GetLatestFrames() []frameSnapshot {
 	a.framesMu.RLock()
 	list := make([]frameSnapshot, 0, len(a.latestFrames))
@@ -135,20 +567,103 @@ func (a *App) GetLatestFrames() []frameSnapshot {
 		list = append(list, *v)
 	}
 	a.framesMu.RUnlock()
+	for _, sc := range a.serverConnections() {
+		list = append(list, sc.snapshots()...)
+	}
+	for i := range list {
+		list[i] = a.withMetadata(list[i])
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].AgentID != list[j].AgentID {
+			return list[i].AgentID < list[j].AgentID
+		}
+		return list[i].Server < list[j].Server
+	})
 	return list
 }
 
+// GetLatestFramesFiltered includeOffline 이 false 이면 마지막 프레임이 오프라인 신호인 Agent 를 제외하고 반환합니다.
+// 전체 목록은 GetLatestFrames 를 사용합니다.
+func (a *App) GetLatestFramesFiltered(includeOffline bool) []frameSnapshot {
+	list := a.GetLatestFrames()
+	if includeOffline {
+		return list
+	}
+	live := list[:0]
+	for _, snap := range list {
+		if !snap.Offline {
+			live = append(live, snap)
+		}
+	}
+	return live
+}
+
+// GetLatestFrame 특정 Agent 의 최신 프레임을 반환합니다. 캐시에 없으면 false 입니다.
+// 타일 하나만 갱신할 때 전체 목록을 복사하지 않도록 사용합니다.
+func (a *App) GetLatestFrame(agentId string) (frameSnapshot, bool) {
+	a.framesMu.RLock()
+	v, ok := a.latestFrames[agentId]
+	a.framesMu.RUnlock()
+	if !ok {
+		return frameSnapshot{}, false
+	}
+	return a.withMetadata(*v), true
+}
+
 // Greet 데모용 메서드 (기존 유지)
 func (a *App) Greet(name string) string {
 	return fmt.Sprintf("Hello %s, It's show time!", name)
 }
 
-// shutdown (선택) - 추후 Wails 종료 시 호출하도록 확장 가능
-func (a *App) shutdown() {
-	if a.cancel != nil {
-		a.cancel()
+// shutdown Wails 앱 종료 훅 (OnShutdown) - 여러 번 호출해도 한 번만 정리합니다.
+// Overview 외에 Detail/Events 스트림도 모두 취소하고, 수신 고루틴이 끝날 때까지 잠시 기다립니다.
+func (a *App) shutdown(_ context.Context) {
+	if !a.started.Load() || !a.stopped.CompareAndSwap(false, true) {
+		return
 	}
-	if a.conn != nil {
-		_ = a.conn.Close()
+	log.Printf("[Admin][BOOT] 종료 처리 시작")
+	a.stop()
+	a.cancelConn()
+	a.stopAllStreams(STREAM_SHUTDOWN_TIMEOUT_MS * time.Millisecond)
+	a.closeConn()
+}
+
+// stopAllStreams 모든 Detail/Events 스트림과 추가 서버 연결을 취소하고 최대 timeout 동안 종료를 기다립니다.
+func (a *App) stopAllStreams(timeout time.Duration) {
+	var handles []*streamHandle
+	a.detailMu.Lock()
+	for agentId, h := range a.detailStreams {
+		handles = append(handles, h)
+		delete(a.detailStreams, agentId)
+	}
+	clear(a.detailWanted)
+	clear(a.detailSince)
+	a.detailMu.Unlock()
+	a.eventsMu.Lock()
+	for agentId, h := range a.eventStreams {
+		handles = append(handles, h)
+		delete(a.eventStreams, agentId)
+	}
+	clear(a.eventsWanted)
+	a.eventsMu.Unlock()
+
+	done := make([]chan struct{}, 0, len(handles))
+	for _, h := range handles {
+		h.cancel()
+		done = append(done, h.done)
+	}
+	for _, sc := range a.serverConnections() {
+		sc.cancel()
+		done = append(done, sc.done)
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for _, ch := range done {
+		select {
+		case <-ch:
+		case <-deadline.C:
+			log.Printf("[Admin][BOOT] 종료 대기 시간 초과 - 남은 스트림 정리 생략")
+			return
+		}
 	}
 }
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+	"admin/proto"
+)
+
+const (
+	// 테스트에서 이벤트/상태를 기다리는 최대 시간
+	TEST_WAIT_TIMEOUT = 5 * time.Second
+	// 조건을 다시 확인하는 주기
+	TEST_POLL_INTERVAL = 10 * time.Millisecond
+)
+
+// recordedEvent는 테스트에서 가로챈 프론트 이벤트입니다.
+type recordedEvent struct {
+	name string
+	data any
+}
+
+// eventRecorder는 WithEventEmitter 로 주입해 발행된 이벤트를 모으는 테스트 도우미입니다.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []recordedEvent
+}
+
+// emit 발행된 이벤트를 기록합니다.
+func (r *eventRecorder) emit(name string, data any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, recordedEvent{name: name, data: data})
+}
+
+// named 지금까지 기록된 name 이벤트의 data 목록을 반환합니다.
+func (r *eventRecorder) named(name string) []any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []any
+	for _, ev := range r.events {
+		if ev.name == name {
+			out = append(out, ev.data)
+		}
+	}
+	return out
+}
+
+// waitFor until 이 참이 될 때까지 poll 을 반복 호출합니다. 시간 초과 시 테스트를 실패시킵니다.
+func waitFor(t *testing.T, what string, poll func(), until func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(TEST_WAIT_TIMEOUT)
+	for !until() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: 시간 초과", what)
+		}
+		if poll != nil {
+			poll()
+		}
+		time.Sleep(TEST_POLL_INTERVAL)
+	}
+}
+
+// startTestApp 하니스 서버에 연결하는 App 을 시작하고 테스트 종료 시 정리합니다.
+func startTestApp(t *testing.T, h *servertest.Harness, opts ...AppOption) (*App, *eventRecorder) {
+	t.Helper()
+	rec := &eventRecorder{}
+	opts = append([]AppOption{WithDialer(h.Dialer()), WithEventEmitter(rec.emit)}, opts...)
+	app := NewApp(opts...)
+	// bufconn 하니스는 평문 gRPC 서버
+	app.tls = tlsSettings{Insecure: true}
+	app.startup(context.Background())
+	t.Cleanup(func() { app.shutdown(context.Background()) })
+	return app, rec
+}
+
+// newTestApp 서버에 연결하지 않는 App 을 생성합니다. (캐시/이벤트 로직 단위 테스트용)
+func newTestApp(opts ...AppOption) (*App, *eventRecorder) {
+	rec := &eventRecorder{}
+	app := NewApp(append([]AppOption{WithEventEmitter(rec.emit)}, opts...)...)
+	return app, rec
+}
+
+// storeTestFrame 프레임을 base64 스냅샷으로 기본 서버 캐시에 저장합니다.
+func storeTestFrame(app *App, frame *proto.FrameData) {
+	server := app.serverAddress()
+	app.storeSnapshot(frame, newFrameSnapshot(frame, base64.StdEncoding.EncodeToString(frame.GetImageData()), server), server)
+}
+
+// payloadAgentId 프레임 이벤트 payload 의 agentId 를 반환합니다.
+func payloadAgentId(data any) string {
+	payload, _ := data.(map[string]any)
+	agentId, _ := payload["agentId"].(string)
+	return agentId
+}
+
+func TestOverviewFrameReachesFrontend(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	_, rec := startTestApp(t, h)
+
+	// Overview 구독이 서버에 등록되기 전의 프레임은 유실될 수 있으므로 이벤트가 올 때까지 반복 전송
+	// (HandleIncomingFrame 은 프레임을 제자리 수정하므로 매번 새로 생성)
+	waitFor(t, "overviewFrame 이벤트", func() {
+		h.Service.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("jpeg"), Timestamp: time.Now().UnixMilli()})
+	}, func() bool {
+		for _, data := range rec.named(EVENT_OVERVIEW_FRAME) {
+			if payloadAgentId(data) == "agent-1" {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// addrRecorder는 dialer 에 전달된 주소를 기록하며 하니스로 연결합니다.
+type addrRecorder struct {
+	mu    sync.Mutex
+	addrs []string
+	dial  func(context.Context, string) (net.Conn, error)
+}
+
+func (r *addrRecorder) dialer(ctx context.Context, addr string) (net.Conn, error) {
+	r.mu.Lock()
+	r.addrs = append(r.addrs, addr)
+	r.mu.Unlock()
+	return r.dial(ctx, addr)
+}
+
+// dialed addr 로 연결을 시도한 적이 있는지 반환합니다.
+func (r *addrRecorder) dialed(addr string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, a := range r.addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConnectDialsConfiguredAddress(t *testing.T) {
+	t.Setenv(ENV_GRPC_SERVER_ADDRESS, "admin-server:6000")
+	h := servertest.Start(nil)
+	defer h.Close()
+	rec := &addrRecorder{dial: h.Dialer()}
+	app, _ := startTestApp(t, h, WithDialer(rec.dialer))
+
+	waitFor(t, "환경변수 주소로 연결", nil, func() bool { return rec.dialed("admin-server:6000") })
+	if err := app.SetServerAddress("other-server:7000"); err != nil {
+		t.Fatalf("SetServerAddress 오류 = %v", err)
+	}
+	waitFor(t, "변경한 주소로 재연결", nil, func() bool { return rec.dialed("other-server:7000") })
+	if got := app.GetServerAddress(); got != "other-server:7000" {
+		t.Fatalf("GetServerAddress = %q", got)
+	}
+}
+
+func TestGetLatestFrameHitAndMiss(t *testing.T) {
+	app, _ := newTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: 1000})
+
+	snap, ok := app.GetLatestFrame("agent-1")
+	if !ok {
+		t.Fatal("캐시된 Agent 조회 실패")
+	}
+	if snap.AgentID != "agent-1" || snap.Timestamp != 1000 || snap.ImageBase != base64.StdEncoding.EncodeToString([]byte("img")) {
+		t.Fatalf("GetLatestFrame = %+v", snap)
+	}
+	if _, ok := app.GetLatestFrame("agent-2"); ok {
+		t.Fatal("캐시에 없는 Agent 가 조회됨")
+	}
+}
+
+// latestAgentIds GetLatestFrames 결과의 AgentID 순서를 반환합니다.
+func latestAgentIds(list []frameSnapshot) []string {
+	ids := make([]string, 0, len(list))
+	for _, snap := range list {
+		ids = append(ids, snap.AgentID)
+	}
+	return ids
+}
+
+func TestGetLatestFramesSortedByAgentId(t *testing.T) {
+	app, _ := newTestApp()
+	for _, agentId := range []string{"agent-c", "agent-a", "agent-e", "agent-b", "agent-d"} {
+		storeTestFrame(app, &proto.FrameData{AgentId: agentId, ImageData: []byte("img"), Timestamp: 1000})
+	}
+
+	want := []string{"agent-a", "agent-b", "agent-c", "agent-d", "agent-e"}
+	// 맵 순회 순서와 무관하게 매 호출 같은 순서
+	for range 10 {
+		if got := latestAgentIds(app.GetLatestFrames()); !slices.Equal(got, want) {
+			t.Fatalf("GetLatestFrames 순서 = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestGetLatestFramesFilteredExcludesOffline(t *testing.T) {
+	app, _ := newTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-a", ImageData: []byte("img"), Timestamp: 1000})
+	// 구버전 오프라인 신호(타임스탬프 0 + 빈 이미지)와 Offline 플래그 모두 오프라인으로 취급
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-b", ImageData: []byte{}, Timestamp: 0})
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-c", ImageData: []byte("img"), Timestamp: 1000})
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-d", Offline: true})
+
+	if got, want := latestAgentIds(app.GetLatestFramesFiltered(false)), []string{"agent-a", "agent-c"}; !slices.Equal(got, want) {
+		t.Fatalf("GetLatestFramesFiltered(false) = %v, want %v", got, want)
+	}
+	all := []string{"agent-a", "agent-b", "agent-c", "agent-d"}
+	if got := latestAgentIds(app.GetLatestFramesFiltered(true)); !slices.Equal(got, all) {
+		t.Fatalf("GetLatestFramesFiltered(true) = %v, want %v", got, all)
+	}
+	if got := latestAgentIds(app.GetLatestFrames()); !slices.Equal(got, all) {
+		t.Fatalf("GetLatestFrames = %v, want %v", got, all)
+	}
+}
+
+func TestFrameStatusName(t *testing.T) {
+	cases := map[string]*proto.FrameData{
+		FRAME_STATUS_LIVE:    {AgentId: "a", ImageData: []byte("img"), Timestamp: 1000},
+		FRAME_STATUS_OFFLINE: {AgentId: "a", Offline: true},
+		FRAME_STATUS_ERROR:   {AgentId: "a", Timestamp: 1000, Status: proto.FrameStatus_FRAME_STATUS_ERROR, StatusMessage: "capture failed"},
+	}
+	for want, frame := range cases {
+		if got := frameStatusName(frame); got != want {
+			t.Errorf("frameStatusName(%v) = %q, want %q", frame, got, want)
+		}
+	}
+	// 구버전 오프라인 신호도 offline 으로 변환
+	if got := frameStatusName(&proto.FrameData{AgentId: "a", ImageData: []byte{}, Timestamp: 0}); got != FRAME_STATUS_OFFLINE {
+		t.Fatalf("구버전 오프라인 신호 상태 = %q", got)
+	}
+}
+
+func TestOverviewOfflinePayloadFlag(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	app.SetOfflineGrace(0)
+	app.SetOverviewEmitRate(0)
+	waitConnected(t, app)
+	waitFor(t, "Overview 구독 등록", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+
+	// 같은 Agent 의 프레임은 서버에서 병합될 수 있으므로 하나씩 확인
+	latest := func(offline bool) func() bool {
+		return func() bool {
+			for _, data := range rec.named(EVENT_OVERVIEW_FRAME) {
+				payload, _ := data.(map[string]any)
+				if payload["agentId"] == "agent-1" && payload["offline"] == offline {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	pushFrame(h, "agent-1", "live")
+	waitFor(t, "live overviewFrame 이벤트", nil, latest(false))
+	h.Service.PublishAgentOffline("agent-1")
+	waitFor(t, "offline overviewFrame 이벤트", nil, latest(true))
+
+	events := rec.named(EVENT_OVERVIEW_FRAME)
+	payload, _ := events[len(events)-1].(map[string]any)
+	if payload["status"] != FRAME_STATUS_OFFLINE || payload["imageBase64"] != "" {
+		t.Fatalf("offline payload = %v", payload)
+	}
+}
+
+func TestAgentDiscoveredEmittedOnce(t *testing.T) {
+	app, rec := newTestApp()
+	for i := range 3 {
+		storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: int64(1000 + i)})
+	}
+	// 오프라인 신호만 온 Agent 는 발견으로 보지 않음
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-2", Offline: true})
+
+	found := rec.named(EVENT_AGENT_DISCOVERED)
+	if len(found) != 1 {
+		t.Fatalf("agentDiscovered 이벤트 = %v, want 1 개", found)
+	}
+	payload, _ := found[0].(map[string]any)
+	if payload["agentId"] != "agent-1" || payload["timestamp"] != int64(1000) {
+		t.Fatalf("agentDiscovered payload = %v", payload)
+	}
+
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-3", ImageData: []byte("img"), Timestamp: 2000})
+	if got := len(rec.named(EVENT_AGENT_DISCOVERED)); got != 2 {
+		t.Fatalf("새 Agent agentDiscovered 수 = %d, want 2", got)
+	}
+}
+
+func TestAgentDiscoveredBeforeFrameEvent(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	app.SetOverviewEmitRate(0)
+	waitConnected(t, app)
+	waitFor(t, "Overview 구독 등록", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+
+	pushFrame(h, "agent-1", "img")
+	waitFor(t, "overviewFrame 이벤트", nil, func() bool { return len(rec.named(EVENT_OVERVIEW_FRAME)) > 0 })
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	for _, ev := range rec.events {
+		switch ev.name {
+		case EVENT_AGENT_DISCOVERED:
+			return
+		case EVENT_OVERVIEW_FRAME:
+			t.Fatal("agentDiscovered 보다 overviewFrame 이 먼저 발행됨")
+		}
+	}
+	t.Fatal("agentDiscovered 이벤트 없음")
+}
@@ -0,0 +1,92 @@
+package main
+
+// 프론트 오류 이벤트 (appError)
+// - 연결/구독 실패를 로그로만 남기면 UI 는 빈 화면만 보이므로, 실패 지점에서 분류를 붙여 appError 이벤트로 전달
+// - 분류: dial(연결 설정/연결), health(사전 상태 확인), subscribe(구독 요청), recv(수신 중 끊김), decode(메시지 해석 실패)
+// - retryable 은 자동 재연결로 해결될 수 있는지 여부 (인증/권한/설정 오류는 false)
+// - 재연결 요청·종료로 인한 취소는 오류로 발행하지 않음
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 오류 이벤트 이름
+	EVENT_APP_ERROR = "appError"
+	// 오류 분류
+	ERROR_CATEGORY_DIAL      = "dial"
+	ERROR_CATEGORY_HEALTH    = "health"
+	ERROR_CATEGORY_SUBSCRIBE = "subscribe"
+	ERROR_CATEGORY_RECV      = "recv"
+	ERROR_CATEGORY_DECODE    = "decode"
+)
+
+// categorizedError는 실패 지점 분류와 재시도 가능 여부가 붙은 오류입니다.
+type categorizedError struct {
+	category  string
+	retryable bool
+	err       error
+}
+
+func (e *categorizedError) Error() string {
+	return fmt.Sprintf("%s: %v", e.category, e.err)
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.err
+}
+
+// categorize 오류에 실패 지점 분류를 붙입니다. 인증/권한/잘못된 요청 오류는 재시도 불가로 표시합니다.
+func categorize(category string, err error) error {
+	retryable := true
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.InvalidArgument:
+		retryable = false
+	}
+	return &categorizedError{category: category, retryable: retryable, err: err}
+}
+
+// categorizeFatal 설정을 고치기 전까지 반복될 오류(TLS 인증서 등)에 분류를 붙입니다.
+func categorizeFatal(category string, err error) error {
+	return &categorizedError{category: category, retryable: false, err: err}
+}
+
+// recvErrorCategory 수신 오류가 메시지 해석 실패(Internal)이면 decode, 아니면 recv 로 분류합니다.
+func recvErrorCategory(err error) string {
+	if status.Code(err) == codes.Internal {
+		return ERROR_CATEGORY_DECODE
+	}
+	return ERROR_CATEGORY_RECV
+}
+
+// appErrorPayload는 프론트로 전달하는 오류 정보입니다.
+type appErrorPayload struct {
+	Category      string `json:"category"`
+	Message       string `json:"message"`
+	Retryable     bool   `json:"retryable"`
+	ServerAddress string `json:"serverAddress"`
+}
+
+// emitAppError 연결 루프 실패를 appError 이벤트로 발행합니다. 취소(재연결 요청/종료)는 발행하지 않습니다.
+func (a *App) emitAppError(err error) {
+	if err == nil || status.Code(err) == codes.Canceled || errors.Is(err, context.Canceled) {
+		return
+	}
+	payload := appErrorPayload{
+		Category:      ERROR_CATEGORY_RECV,
+		Message:       err.Error(),
+		Retryable:     true,
+		ServerAddress: a.serverAddress(),
+	}
+	var ce *categorizedError
+	if errors.As(err, &ce) {
+		payload.Category = ce.category
+		payload.Retryable = ce.retryable
+	}
+	a.emit(EVENT_APP_ERROR, payload)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDialFailureEmitsAppError(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	refuse := func(context.Context, string) (net.Conn, error) { return nil, errors.New("connection refused") }
+	app, rec := startTestApp(t, h, WithDialer(refuse), WithDialTimeout(100*time.Millisecond))
+
+	waitFor(t, "appError 이벤트", nil, func() bool { return len(rec.named(EVENT_APP_ERROR)) > 0 })
+	payload, ok := rec.named(EVENT_APP_ERROR)[0].(appErrorPayload)
+	if !ok {
+		t.Fatalf("appError payload 타입 = %T", rec.named(EVENT_APP_ERROR)[0])
+	}
+	if payload.Category != ERROR_CATEGORY_DIAL || !payload.Retryable {
+		t.Fatalf("appError = %+v, want dial/retryable", payload)
+	}
+	if payload.ServerAddress != app.serverAddress() || payload.Message == "" {
+		t.Fatalf("appError 주소/메시지 = %q %q", payload.ServerAddress, payload.Message)
+	}
+}
+
+func TestAppErrorCategories(t *testing.T) {
+	app, rec := newTestApp()
+	app.emitAppError(categorize(ERROR_CATEGORY_SUBSCRIBE, status.Error(codes.PermissionDenied, "denied")))
+	app.emitAppError(categorize(ERROR_CATEGORY_RECV, status.Error(codes.Canceled, "reconnect")))
+	app.emitAppError(context.Canceled)
+	app.emitAppError(errors.New("plain"))
+
+	events := rec.named(EVENT_APP_ERROR)
+	// 취소는 발행하지 않음
+	if len(events) != 2 {
+		t.Fatalf("appError 이벤트 = %v, want 2 개", events)
+	}
+	denied := events[0].(appErrorPayload)
+	if denied.Category != ERROR_CATEGORY_SUBSCRIBE || denied.Retryable || !strings.Contains(denied.Message, "denied") {
+		t.Fatalf("권한 오류 appError = %+v, want subscribe/재시도 불가", denied)
+	}
+	// 분류 없는 오류는 재시도 가능한 recv 로 봄
+	if plain := events[1].(appErrorPayload); plain.Category != ERROR_CATEGORY_RECV || !plain.Retryable {
+		t.Fatalf("분류 없는 appError = %+v, want recv/재시도 가능", plain)
+	}
+	if got := recvErrorCategory(status.Error(codes.Internal, "bad message")); got != ERROR_CATEGORY_DECODE {
+		t.Fatalf("Internal 수신 오류 분류 = %q, want decode", got)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+// gRPC 토큰 인증 (클라이언트)
+// - ADMIN_GRPC_TOKEN 이 설정되면 모든 RPC 에 authorization: Bearer <token> 메타데이터 첨부
+// - 서버는 토큰 소유 adminId 와 요청 adminId 를 비교하므로 ADMIN_ID 로 식별자를 고정해야 함
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// 인증 토큰 환경변수 이름
+	ENV_GRPC_TOKEN = "ADMIN_GRPC_TOKEN"
+	// Admin 식별자 환경변수 이름 (미설정 시 실행마다 생성)
+	ENV_ADMIN_ID = "ADMIN_ID"
+)
+
+// bearerToken은 grpc.PerRPCCredentials 구현입니다.
+type bearerToken struct {
+	token      string
+	requireTLS bool
+}
+
+// GetRequestMetadata RPC 마다 authorization 메타데이터를 반환합니다.
+func (b bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + b.token}, nil
+}
+
+// RequireTransportSecurity 평문 연결에서도 토큰을 보낼지 여부 (insecure 설정 시 false)
+func (b bearerToken) RequireTransportSecurity() bool {
+	return b.requireTLS
+}
+
+// tokenDialOption 토큰이 설정된 경우 PerRPCCredentials DialOption 을 반환합니다.
+func tokenDialOption(token string, insecure bool) (grpc.DialOption, bool) {
+	if token == "" {
+		return nil, false
+	}
+	return grpc.WithPerRPCCredentials(bearerToken{token: token, requireTLS: !insecure}), true
+}
@@ -0,0 +1,74 @@
+package main
+
+// 재연결 지수 백오프 (jitter 포함)
+// - 실패할 때마다 대기 시간을 2배로 늘리고 상한에서 멈춤
+// - 여러 Admin 클라이언트가 동시에 재시도하지 않도록 무작위 jitter 적용
+// - 일정 시간 이상 유지된 구독 이후에는 하한으로 초기화
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	// 재연결 대기 하한
+	RECONNECT_BACKOFF_MIN_MS = 500
+	// 재연결 대기 상한
+	RECONNECT_BACKOFF_MAX_MS = 30000
+	// 이 시간 이상 유지된 구독은 정상 연결로 보고 백오프를 초기화
+	RECONNECT_STABLE_MS = 5000
+)
+
+// backoffState는 프론트 표시용 백오프 상태입니다.
+type backoffState struct {
+	Attempt int   `json:"attempt"`
+	DelayMs int64 `json:"delayMs"`
+}
+
+// reconnectBackoff는 재연결 대기 시간을 계산합니다.
+type reconnectBackoff struct {
+	mu      sync.Mutex
+	min     time.Duration
+	max     time.Duration
+	attempt int
+	delay   time.Duration
+}
+
+// newReconnectBackoff reconnectBackoff 생성자
+func newReconnectBackoff(min, max time.Duration) *reconnectBackoff {
+	return &reconnectBackoff{min: min, max: max}
+}
+
+// next 다음 재시도까지의 대기 시간을 계산하고 시도 횟수를 증가시킵니다.
+// 기준값(min * 2^attempt, 상한 max)의 절반 + [0, 절반) 범위의 jitter 를 사용합니다.
+func (b *reconnectBackoff) next() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	base := b.min
+	for i := 0; i < b.attempt && base < b.max; i++ {
+		base *= 2
+	}
+	if base > b.max {
+		base = b.max
+	}
+	half := base / 2
+	b.delay = half + time.Duration(rand.Int64N(int64(half)+1))
+	b.attempt++
+	return b.delay
+}
+
+// reset 백오프를 하한으로 초기화합니다.
+func (b *reconnectBackoff) reset() {
+	b.mu.Lock()
+	b.attempt = 0
+	b.delay = 0
+	b.mu.Unlock()
+}
+
+// state 현재 백오프 상태를 반환합니다.
+func (b *reconnectBackoff) state() backoffState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return backoffState{Attempt: b.attempt, DelayMs: b.delay.Milliseconds()}
+}
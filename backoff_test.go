@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDoublesWithinJitterAndCaps(t *testing.T) {
+	b := newReconnectBackoff(100*time.Millisecond, time.Second)
+	// 기준값: 100, 200, 400, 800, 1000(상한), 1000
+	for i, base := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		base *= time.Millisecond
+		d := b.next()
+		if d < base/2 || d > base {
+			t.Fatalf("시도 %d 대기 = %s, want [%s, %s]", i, d, base/2, base)
+		}
+		if st := b.state(); st.Attempt != i+1 || st.DelayMs != d.Milliseconds() {
+			t.Fatalf("시도 %d 상태 = %+v", i, st)
+		}
+	}
+}
+
+func TestBackoffReset(t *testing.T) {
+	b := newReconnectBackoff(100*time.Millisecond, time.Second)
+	for range 5 {
+		b.next()
+	}
+	b.reset()
+	if st := b.state(); st.Attempt != 0 || st.DelayMs != 0 {
+		t.Fatalf("reset 후 상태 = %+v", st)
+	}
+	if d := b.next(); d < 50*time.Millisecond || d > 100*time.Millisecond {
+		t.Fatalf("reset 후 대기 = %s, want 하한 범위", d)
+	}
+}
+
+func TestBackoffJitterSpreads(t *testing.T) {
+	// 같은 시도 횟수라도 클라이언트마다 대기 시간이 달라야 동시 재시도가 분산됨
+	seen := make(map[time.Duration]struct{})
+	for range 20 {
+		seen[newReconnectBackoff(time.Second, 30*time.Second).next()] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Fatal("jitter 가 적용되지 않음")
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+// Overview 묶음 수신 (FrameBatch)
+// - ADMIN_OVERVIEW_BATCH=true 이면 SubscribeOverviewBatch 로 여러 프레임을 한 메시지로 받아 전송 부담을 줄임
+// - 받은 묶음은 Agent 별 프레임으로 풀어 기존과 같은 overviewFrame 이벤트로 전달
+// - 서버가 묶음 RPC 를 지원하지 않으면(Unimplemented) 끄고 기존 단건 구독으로 재연결
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"admin/proto"
+)
+
+const (
+	// 묶음 수신 사용 여부 환경변수 이름
+	ENV_OVERVIEW_BATCH = "ADMIN_OVERVIEW_BATCH"
+	// 서버에 요청하는 묶음당 최대 프레임 수
+	OVERVIEW_BATCH_MAX_FRAMES = 64
+	// 서버에 요청하는 묶음 대기 시간 (미리보기 지연 허용치)
+	OVERVIEW_BATCH_DELAY_MS = 50
+)
+
+// overviewBatchFromEnv 환경변수로 묶음 수신 사용 여부를 읽습니다.
+func overviewBatchFromEnv() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(ENV_OVERVIEW_BATCH))
+	return enabled
+}
+
+// overviewRecvFunc 다음 프레임 묶음을 받습니다. (단건 스트림이면 1개짜리 묶음)
+type overviewRecvFunc func() ([]*proto.FrameData, error)
+
+// openOverview 설정에 따라 단건/묶음 Overview 스트림을 열고 수신 함수를 반환합니다.
+func (a *App) openOverview(ctx context.Context, req *proto.AdminSubscribeRequest) (overviewRecvFunc, error) {
+	client := a.client()
+	if !a.overviewBatch.Load() {
+		stream, err := client.SubscribeOverview(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return func() ([]*proto.FrameData, error) {
+			frame, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return []*proto.FrameData{frame}, nil
+		}, nil
+	}
+	req.BatchMaxFrames = OVERVIEW_BATCH_MAX_FRAMES
+	req.BatchMaxDelayMs = OVERVIEW_BATCH_DELAY_MS
+	stream, err := client.SubscribeOverviewBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return func() ([]*proto.FrameData, error) {
+		batch, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return batch.GetFrames(), nil
+	}, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+// 클라이언트 집계 통계 이벤트
+// - 상태 표시줄이 프레임 스트림에서 직접 계산하지 않도록 주기적으로 clientStats 이벤트 하나로 요약 발행
+// - 알려진 Agent 수 / 온라인 수 / 직전 발행 이후 수신 프레임 수 / 현재 백오프 / 연결 상태 포함
+// - ADMIN_CLIENT_STATS_MS 로 간격 조정 (기본 5000, 0 이면 비활성), shutdown 시 a.ctx 취소로 종료
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// 집계 통계 이벤트 이름 / 간격 환경변수 / 기본 간격(ms)
+	EVENT_CLIENT_STATS       = "clientStats"
+	ENV_CLIENT_STATS_MS      = "ADMIN_CLIENT_STATS_MS"
+	CLIENT_STATS_INTERVAL_MS = 5000
+)
+
+// clientStats는 clientStats 이벤트 payload 입니다.
+type clientStats struct {
+	Agents         int              `json:"agents"`
+	Online         int              `json:"online"`
+	FramesReceived uint64           `json:"framesReceived"` // 직전 발행 이후 수신한 Overview/Detail 프레임 수
+	IntervalMs     int64            `json:"intervalMs"`
+	Backoff        backoffState     `json:"backoff"`
+	Connection     connectionStatus `json:"connection"`
+}
+
+// frameCounter는 집계 주기 동안 수신한 프레임 수입니다.
+type frameCounter struct {
+	n atomic.Uint64
+}
+
+// add 수신 프레임 1개를 셉니다.
+func (c *frameCounter) add() {
+	c.n.Add(1)
+}
+
+// take 누적 값을 반환하고 0 으로 초기화합니다.
+func (c *frameCounter) take() uint64 {
+	return c.n.Swap(0)
+}
+
+// collectClientStats 현재 캐시/연결 상태로 집계 통계를 만듭니다.
+func (a *App) collectClientStats(interval time.Duration) clientStats {
+	st := clientStats{
+		FramesReceived: a.framesReceived.take(),
+		IntervalMs:     interval.Milliseconds(),
+		Backoff:        a.backoff.state(),
+		Connection:     a.GetConnectionStatus(),
+	}
+	for _, snap := range a.GetLatestFrames() {
+		st.Agents++
+		if !snap.Offline {
+			st.Online++
+		}
+	}
+	return st
+}
+
+// clientStatsLoop 주기적으로 clientStats 이벤트를 발행합니다.
+func (a *App) clientStatsLoop() {
+	interval := time.Duration(envInt(ENV_CLIENT_STATS_MS, CLIENT_STATS_INTERVAL_MS)) * time.Millisecond
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.emit(EVENT_CLIENT_STATS, a.collectClientStats(interval))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+)
+
+func TestClientStatsEmitted(t *testing.T) {
+	t.Setenv(ENV_CLIENT_STATS_MS, "50")
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	waitConnected(t, app)
+
+	var received uint64
+	waitFor(t, "clientStats 이벤트", func() {
+		pushFrame(h, "agent-1", "img")
+		pushFrame(h, "agent-2", "img")
+	}, func() bool {
+		events := rec.named(EVENT_CLIENT_STATS)
+		if len(events) == 0 {
+			return false
+		}
+		st := events[len(events)-1].(clientStats)
+		received += st.FramesReceived
+		return st.Agents == 2 && st.Online == 2 && received > 0
+	})
+	events := rec.named(EVENT_CLIENT_STATS)
+	st := events[len(events)-1].(clientStats)
+	if st.IntervalMs != 50 || st.Connection.State != CONNECTION_STATE_CONNECTED || st.Connection.ServerAddress == "" {
+		t.Fatalf("clientStats = %+v", st)
+	}
+
+	// shutdown 후에는 더 이상 발행하지 않음
+	app.shutdown(context.Background())
+	// 취소 직전에 시작된 발행이 끝날 때까지 한 주기 대기
+	time.Sleep(100 * time.Millisecond)
+	n := len(rec.named(EVENT_CLIENT_STATS))
+	time.Sleep(200 * time.Millisecond)
+	if got := len(rec.named(EVENT_CLIENT_STATS)); got != n {
+		t.Fatalf("shutdown 후 clientStats 발행 %d → %d", n, got)
+	}
+}
+
+func TestClientStatsFramesReceivedResets(t *testing.T) {
+	app, _ := newTestApp()
+	app.framesReceived.add()
+	app.framesReceived.add()
+	if st := app.collectClientStats(time.Second); st.FramesReceived != 2 || st.IntervalMs != 1000 {
+		t.Fatalf("첫 집계 = %+v, want 수신 2", st)
+	}
+	if st := app.collectClientStats(time.Second); st.FramesReceived != 0 {
+		t.Fatalf("다음 집계 수신 = %d, want 0", st.FramesReceived)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+// gRPC 압축 (클라이언트)
+// - ADMIN_GRPC_COMPRESSION=gzip 이면 모든 RPC 에 gzip 압축을 요청 (기본 비활성)
+// - 서버가 압축을 켜 두었으면 구독 스트림도 gzip 으로 받음, 아니면 무압축으로 동작
+// - JPEG 미리보기는 이미 압축되어 이득이 거의 없고 CPU 만 쓰므로 raw 프레임/느린 링크에서만 사용
+
+import (
+	"log"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// 압축 방식 환경변수 이름 (현재 "gzip" 만 지원)
+	ENV_GRPC_COMPRESSION = "ADMIN_GRPC_COMPRESSION"
+)
+
+// compressionDialOption 환경변수 설정에 따라 압축 호출 옵션을 반환합니다. 미설정이면 false 입니다.
+func compressionDialOption() (grpc.DialOption, bool) {
+	switch name := os.Getenv(ENV_GRPC_COMPRESSION); name {
+	case "":
+		return nil, false
+	case gzip.Name:
+		return grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)), true
+	default:
+		log.Printf("[Admin][BOOT] %s 값 무시: 지원하지 않는 압축 방식 %q", ENV_GRPC_COMPRESSION, name)
+		return nil, false
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestCompressionDialOptionFromEnvironment(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"brotli", false},
+	} {
+		t.Setenv(ENV_GRPC_COMPRESSION, tc.value)
+		if _, ok := compressionDialOption(); ok != tc.want {
+			t.Fatalf("%s=%q 압축 옵션 = %v, want %v", ENV_GRPC_COMPRESSION, tc.value, ok, tc.want)
+		}
+	}
+}
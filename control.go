@@ -0,0 +1,44 @@
+package main
+
+// 구독 제어 (Control RPC)
+// - 실행 중인 구독의 파라미터를 재연결 없이 바꾸기 위해 Control 양방향 스트림으로 요청 1개를 보내고 응답 1개를 받음
+// - 호출 빈도가 낮아 요청마다 스트림을 열고 닫음 (구버전 서버는 Unimplemented)
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sendControl 제어 요청을 보내고 서버의 적용 결과를 반환합니다.
+func (a *App) sendControl(req *proto.ControlRequest) error {
+	client := a.client()
+	if client == nil {
+		return errors.New("not connected to server")
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	stream, err := client.Control(ctx)
+	if err != nil {
+		return fmt.Errorf("control: %w", err)
+	}
+	req.AdminId = a.adminID
+	if err := stream.Send(req); err != nil {
+		return fmt.Errorf("control send: %w", err)
+	}
+	resp, err := stream.Recv()
+	_ = stream.CloseSend()
+	if err != nil {
+		return fmt.Errorf("control recv: %w", err)
+	}
+	if !resp.GetOk() {
+		return fmt.Errorf("control rejected: %w", status.Error(codes.Code(resp.GetCode()), resp.GetError()))
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package main
+
+// Detail 스트림 브리지
+// - StartDetail/StopDetail 로 특정 Agent 의 고해상도 프레임 구독을 열고 닫음
+// - 수신 프레임은 detailFrame:<agentId> 이벤트로 프론트에 전달
+// - Agent 별 cancel 함수는 detailMu 로 보호되는 맵에서 관리 (중복 호출 안전)
+// - 구독 RPC 는 슬롯을 먼저 예약한 뒤 detailMu 밖에서 호출 (연결 지연 중에도 다른 Agent 의 Start/Stop 을 막지 않음)
+// - 서버가 스트림을 끊으면 streamStatus(closed) 이벤트로 알림 (StopDetail 로 중지한 경우 제외)
+// - StopDetail 전까지는 구독 의사(detailWanted)를 유지하여 재연결 후 다시 구독
+// - 재구독 시 마지막으로 받은 프레임 타임스탬프를 since_timestamp 로 보내 서버 캐시에서 그보다 새 최신 프레임 1개만 받아 이어감
+//   (at-least-once: 끊기기 직전 프레임이 전달 도중이었다면 같은 프레임을 한 번 더 받을 수 있고, 사이의 중간 프레임은 건너뜀)
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"admin/proto"
+)
+
+const (
+	// Detail 프레임 이벤트 이름 접두사 (뒤에 agentId 가 붙음)
+	EVENT_DETAIL_FRAME_PREFIX = "detailFrame:"
+)
+
+// streamHandle는 실행 중인 구독 스트림의 취소 함수와 종료 신호입니다.
+type streamHandle struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	// Detail 전용: 마지막으로 받은 프레임 타임스탬프 (재구독 since_timestamp 용)
+	lastTimestamp atomic.Int64
+}
+
+// StartDetail 특정 Agent 의 Detail 스트림을 시작합니다. 이미 실행 중이면 아무것도 하지 않습니다.
+func (a *App) StartDetail(agentId string) error {
+	if agentId == "" {
+		return errors.New("agentId is required")
+	}
+	client := a.client()
+	if client == nil {
+		return errors.New("not connected to server")
+	}
+	_, err := a.openDetail(client, agentId, false)
+	return err
+}
+
+// openDetail Detail 스트림 슬롯을 예약한 뒤 detailMu 밖에서 스트림을 열고 수신 고루틴을 시작합니다.
+// 이미 실행(또는 여는) 중이거나, onlyWanted 인데 구독 의사가 없으면(StopDetail 됨) false 를 반환합니다.
+func (a *App) openDetail(client proto.AdminServiceClient, agentId string, onlyWanted bool) (bool, error) {
+	a.detailMu.Lock()
+	h, ctx, wasWanted := a.reserveStream(a.detailStreams, a.detailWanted, agentId, onlyWanted)
+	since := a.detailSince[agentId]
+	a.detailMu.Unlock()
+	if h == nil {
+		return false, nil
+	}
+	h.lastTimestamp.Store(since)
+	stream, err := client.SubscribeDetail(ctx, &proto.AgentDetailRequest{AdminId: a.adminID, AgentId: agentId, SinceTimestamp: since})
+	if err != nil {
+		releaseStream(&a.detailMu, a.detailStreams, a.detailWanted, agentId, h, wasWanted)
+		return false, fmt.Errorf("subscribe detail: %w", err)
+	}
+	go a.recvDetail(agentId, stream, h)
+	log.Printf("[Admin][STREAM] detail(%s) 구독 시작 (since=%d)", agentId, since)
+	return true, nil
+}
+
+// reserveStream 스트림 슬롯에 아직 열리지 않은 핸들을 등록하고 구독 의사를 기록합니다. (mu 잠금 상태에서 호출)
+// 구독 RPC 는 잠금 밖에서 호출하므로, 그동안 같은 Agent 의 Start 는 예약을 보고 건너뛰고 Stop 은 cancel 로 RPC 를 취소합니다.
+// 이미 실행(또는 여는) 중이거나 onlyWanted 인데 구독 의사가 없으면 nil 핸들을 반환하며, wasWanted 는 예약 전 구독 의사 여부입니다.
+func (a *App) reserveStream(streams map[string]*streamHandle, wanted map[string]struct{}, agentId string, onlyWanted bool) (h *streamHandle, ctx context.Context, wasWanted bool) {
+	_, wasWanted = wanted[agentId]
+	if _, ok := streams[agentId]; ok || (onlyWanted && !wasWanted) {
+		return nil, nil, wasWanted
+	}
+	ctx, cancel := context.WithCancel(a.ctx)
+	h = &streamHandle{cancel: cancel, done: make(chan struct{})}
+	streams[agentId] = h
+	wanted[agentId] = struct{}{}
+	return h, ctx, wasWanted
+}
+
+// releaseStream 열지 못한 예약을 해제하고 핸들을 종료 상태로 만듭니다. (잠금 밖에서 호출)
+// 예약 중 Stop 되어 이미 교체/삭제된 슬롯은 건드리지 않으며, 예약 전에 없던 구독 의사는 되돌립니다.
+func releaseStream(mu *sync.Mutex, streams map[string]*streamHandle, wanted map[string]struct{}, agentId string, h *streamHandle, wasWanted bool) {
+	mu.Lock()
+	if streams[agentId] == h {
+		delete(streams, agentId)
+		if !wasWanted {
+			delete(wanted, agentId)
+		}
+	}
+	mu.Unlock()
+	h.cancel()
+	close(h.done)
+}
+
+// StopDetail 특정 Agent 의 Detail 스트림을 취소합니다. 실행 중이 아니면 아무것도 하지 않습니다.
+func (a *App) StopDetail(agentId string) {
+	a.detailMu.Lock()
+	h, ok := a.detailStreams[agentId]
+	delete(a.detailStreams, agentId)
+	delete(a.detailWanted, agentId)
+	delete(a.detailSince, agentId)
+	a.detailMu.Unlock()
+	// 녹화는 recordMu → detailMu 순서로 잠그므로 detailMu 를 놓은 뒤 정리
+	a.endRecordingForDetail(agentId)
+	if !ok {
+		return
+	}
+	h.cancel()
+	<-h.done
+	log.Printf("[Admin][STREAM] detail(%s) 구독 중지", agentId)
+}
+
+// recvDetail Detail 스트림을 수신하여 이벤트로 전파합니다.
+func (a *App) recvDetail(agentId string, stream proto.AdminService_SubscribeDetailClient, h *streamHandle) {
+	defer close(h.done)
+	defer func() {
+		// 스트림이 스스로 끝난 경우 맵에서 제거 (StopDetail 로 이미 교체/삭제된 경우 제외)
+		a.detailMu.Lock()
+		if a.detailStreams[agentId] == h {
+			delete(a.detailStreams, agentId)
+		}
+		// 아직 구독 의사가 있으면 재구독 시 이어받을 위치 보관
+		if _, wanted := a.detailWanted[agentId]; wanted {
+			a.detailSince[agentId] = h.lastTimestamp.Load()
+		}
+		a.detailMu.Unlock()
+		h.cancel()
+	}()
+	eventName := EVENT_DETAIL_FRAME_PREFIX + agentId
+	server := a.serverAddress()
+	for {
+		frame, err := stream.Recv()
+		if err != nil {
+			log.Printf("[Admin][STREAM] detail(%s) 수신 종료: %v", agentId, err)
+			// 사용자가 중지한 경우(context 취소)는 오류로 알리지 않음
+			if stream.Context().Err() == nil {
+				a.emitStreamStatus(STREAM_KIND_DETAIL, agentId, STREAM_STATE_CLOSED, err)
+			}
+			return
+		}
+		if isHeartbeatFrame(frame) {
+			continue
+		}
+		bs := base64.StdEncoding.EncodeToString(frame.GetImageData())
+		a.emit(eventName, frameEventPayload(frame, bs, server))
+		a.recordFrame(agentId, frame)
+		a.framesReceived.add()
+		if ts := frame.GetTimestamp(); ts > h.lastTimestamp.Load() && !isOfflineFrame(frame) {
+			h.lastTimestamp.Store(ts)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// waitConnected App 이 하니스 서버에 연결될 때까지 기다립니다.
+func waitConnected(t *testing.T, app *App) {
+	t.Helper()
+	waitFor(t, "서버 연결", nil, func() bool { return app.client() != nil })
+}
+
+// pushFrame 하니스 서버에 새 프레임을 넣습니다. (HandleIncomingFrame 은 프레임을 제자리 수정하므로 매번 생성)
+func pushFrame(h *servertest.Harness, agentId, image string) {
+	h.Service.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte(image), Timestamp: time.Now().UnixMilli()})
+}
+
+func TestDetailStreamForwardsAndStops(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	waitConnected(t, app)
+
+	if err := app.StartDetail("agent-1"); err != nil {
+		t.Fatalf("StartDetail 오류 = %v", err)
+	}
+	waitFor(t, "서버 Detail 구독 등록", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	pushFrame(h, "agent-1", "detail")
+	pushFrame(h, "agent-2", "other")
+	waitFor(t, "detailFrame 이벤트", nil, func() bool { return len(rec.named(EVENT_DETAIL_FRAME_PREFIX+"agent-1")) == 1 })
+	if got := payloadAgentId(rec.named(EVENT_DETAIL_FRAME_PREFIX + "agent-1")[0]); got != "agent-1" {
+		t.Fatalf("detailFrame agentId = %q", got)
+	}
+	if len(rec.named(EVENT_DETAIL_FRAME_PREFIX+"agent-2")) != 0 {
+		t.Fatal("구독하지 않은 Agent 의 Detail 이벤트 발행")
+	}
+
+	app.StopDetail("agent-1")
+	app.detailMu.Lock()
+	remaining := len(app.detailStreams)
+	app.detailMu.Unlock()
+	if remaining != 0 {
+		t.Fatalf("StopDetail 후 남은 스트림 = %d", remaining)
+	}
+	waitFor(t, "서버 Detail 구독 해제", nil, func() bool { return h.Service.Stats().DetailSubscribers == 0 })
+	pushFrame(h, "agent-1", "after-stop")
+	time.Sleep(TEST_POLL_INTERVAL * 10)
+	if got := len(rec.named(EVENT_DETAIL_FRAME_PREFIX + "agent-1")); got != 1 {
+		t.Fatalf("StopDetail 후 Detail 이벤트 수 = %d, want 1", got)
+	}
+}
+
+// fakeDetailClient는 정해진 오류로 끝나는 Detail 수신 스트림입니다.
+type fakeDetailClient struct {
+	grpc.ClientStream
+	ctx context.Context
+	err error
+}
+
+func (f *fakeDetailClient) Recv() (*proto.FrameData, error) { return nil, f.err }
+func (f *fakeDetailClient) Context() context.Context        { return f.ctx }
+
+func TestRecvDetailEmitsClosedStatus(t *testing.T) {
+	app, rec := newTestApp()
+	h := &streamHandle{cancel: func() {}, done: make(chan struct{})}
+	app.recvDetail("agent-1", &fakeDetailClient{ctx: context.Background(), err: io.ErrUnexpectedEOF}, h)
+	got := rec.named(EVENT_STREAM_STATUS)
+	if len(got) != 1 {
+		t.Fatalf("서버가 끊은 Detail 스트림 상태 이벤트 = %d, want 1", len(got))
+	}
+	if payload := got[0].(map[string]any); payload["kind"] != STREAM_KIND_DETAIL || payload["state"] != STREAM_STATE_CLOSED {
+		t.Fatalf("Detail 종료 상태 = %v", payload)
+	}
+
+	// 사용자가 중지한 경우(context 취소)는 알리지 않음
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h = &streamHandle{cancel: func() {}, done: make(chan struct{})}
+	app.recvDetail("agent-1", &fakeDetailClient{ctx: ctx, err: context.Canceled}, h)
+	if got := len(rec.named(EVENT_STREAM_STATUS)); got != 1 {
+		t.Fatalf("중지한 Detail 스트림 상태 이벤트 = %d, want 1 (추가 없음)", got)
+	}
+}
+
+// blockingSubscribeClient는 구독 RPC 가 context 취소 전까지 돌아오지 않는 클라이언트입니다. (연결 지연 재현)
+type blockingSubscribeClient struct {
+	proto.AdminServiceClient
+	entered chan string
+}
+
+func (c *blockingSubscribeClient) block(ctx context.Context, agentId string) error {
+	c.entered <- agentId
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *blockingSubscribeClient) SubscribeDetail(ctx context.Context, req *proto.AgentDetailRequest, _ ...grpc.CallOption) (proto.AdminService_SubscribeDetailClient, error) {
+	return nil, c.block(ctx, req.GetAgentId())
+}
+
+func (c *blockingSubscribeClient) SubscribeEvents(ctx context.Context, req *proto.AgentDetailRequest, _ ...grpc.CallOption) (proto.AdminService_SubscribeEventsClient, error) {
+	return nil, c.block(ctx, req.GetAgentId())
+}
+
+// lockFree는 mu 를 TEST_WAIT_TIMEOUT 안에 잡을 수 있는지 확인합니다.
+func lockFree(t *testing.T, what string, mu *sync.Mutex) {
+	t.Helper()
+	acquired := make(chan struct{})
+	go func() {
+		mu.Lock()
+		mu.Unlock()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+	case <-time.After(TEST_WAIT_TIMEOUT):
+		t.Fatalf("구독 RPC 대기 중 %s 잠금이 잡혀 있음", what)
+	}
+}
+
+func TestSubscribeRPCRunsOutsideStreamLock(t *testing.T) {
+	for _, tc := range []struct {
+		kind  string
+		start func(*App, string) error
+		stop  func(*App, string)
+		mu    func(*App) *sync.Mutex
+		state func(*App) (streams, wanted int)
+	}{
+		{STREAM_KIND_DETAIL, (*App).StartDetail, (*App).StopDetail, func(a *App) *sync.Mutex { return &a.detailMu }, func(a *App) (int, int) {
+			return len(a.detailStreams), len(a.detailWanted)
+		}},
+		{STREAM_KIND_EVENTS, (*App).StartEvents, (*App).StopEvents, func(a *App) *sync.Mutex { return &a.eventsMu }, func(a *App) (int, int) {
+			return len(a.eventStreams), len(a.eventsWanted)
+		}},
+	} {
+		t.Run(tc.kind, func(t *testing.T) {
+			app, _ := newTestApp()
+			app.ctx = context.Background()
+			client := &blockingSubscribeClient{entered: make(chan string, 1)}
+			app.adminClient = client
+
+			errCh := make(chan error, 1)
+			go func() { errCh <- tc.start(app, "agent-1") }()
+			select {
+			case <-client.entered:
+			case <-time.After(TEST_WAIT_TIMEOUT):
+				t.Fatal("구독 RPC 가 호출되지 않음")
+			}
+
+			// RPC 가 막혀 있어도 잠금은 비어 있고, 같은 Agent 재시작은 예약을 보고 바로 반환
+			lockFree(t, tc.kind, tc.mu(app))
+			if err := tc.start(app, "agent-1"); err != nil {
+				t.Fatalf("예약 중 재시작 오류 = %v, want nil", err)
+			}
+
+			// 중지하면 RPC 가 취소되고 예약이 정리됨
+			tc.stop(app, "agent-1")
+			if err := <-errCh; !errors.Is(err, context.Canceled) && status.Code(err) != codes.Canceled {
+				t.Fatalf("중지된 구독 시작 오류 = %v, want Canceled", err)
+			}
+			tc.mu(app).Lock()
+			streams, wanted := tc.state(app)
+			tc.mu(app).Unlock()
+			if streams != 0 || wanted != 0 {
+				t.Fatalf("중지 후 스트림 %d, 구독 의사 %d, want 0", streams, wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+// 연결 옵션 주입 (App 생성 옵션)
+// - NewApp(WithDialer(...), WithDialOptions(...)) 형태로 기본 연결 방식을 확장
+// - 프록시/유닉스 소켓 전송, keepalive 등 추가 gRPC 옵션, 테스트용 bufconn dialer 주입에 사용
+// - 주입한 옵션은 TLS/토큰/압축 기본 옵션 뒤에 붙어 같은 항목이면 덮어씀 (추가 서버 연결에도 동일 적용)
+// - 아무 옵션도 주지 않으면 기존과 동일하게 동작
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+// AppOption은 App 생성 옵션입니다.
+type AppOption func(*App)
+
+// WithDialer는 서버 연결에 사용할 dialer 를 지정합니다. addr 에는 서버 주소가 그대로 전달됩니다.
+func WithDialer(dialer func(ctx context.Context, addr string) (net.Conn, error)) AppOption {
+	return func(a *App) {
+		a.dialer = dialer
+	}
+}
+
+// WithDialOptions는 모든 연결에 추가할 gRPC DialOption 을 지정합니다.
+func WithDialOptions(opts ...grpc.DialOption) AppOption {
+	return func(a *App) {
+		a.extraDialOptions = append(a.extraDialOptions, opts...)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"admin/internal/server/servertest"
+
+	"google.golang.org/grpc"
+)
+
+func TestWithDialOptionsContextDialerUsed(t *testing.T) {
+	t.Setenv(ENV_GRPC_SERVER_ADDRESS, "unix-socket-proxy:0")
+	h := servertest.Start(nil)
+	defer h.Close()
+	rec := &addrRecorder{dial: h.Dialer()}
+	app, _ := newTestApp(WithDialOptions(grpc.WithContextDialer(rec.dialer)))
+	app.tls = tlsSettings{Insecure: true}
+	app.startup(context.Background())
+	t.Cleanup(func() { app.shutdown(context.Background()) })
+
+	// 주입한 dialer 로만 하니스에 닿을 수 있으므로 연결 성공이 곧 사용 여부
+	waitConnected(t, app)
+	if !rec.dialed("unix-socket-proxy:0") {
+		t.Fatal("WithDialOptions 로 주입한 dialer 가 사용되지 않음")
+	}
+	waitFor(t, "Overview 구독 등록", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+}
+
+func TestDialOptionsDefaultUnchanged(t *testing.T) {
+	app, _ := newTestApp()
+	base, err := app.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	extra, _ := newTestApp(WithDialOptions(grpc.WithUserAgent("test")))
+	opts, err := extra.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 주입한 옵션은 기본 옵션 뒤에 덧붙음
+	if len(opts) != len(base)+1 {
+		t.Fatalf("dialOptions 수 = %d, want %d", len(opts), len(base)+1)
+	}
+}
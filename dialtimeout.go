@@ -0,0 +1,43 @@
+package main
+
+// 초기 연결 제한 시간
+// - grpc.Dial 은 비동기라 연결 실패가 첫 RPC(HealthCheck)에서야 드러나 상태/오류 분류가 health 로 섞임
+// - DialContext + WithBlock 으로 제한 시간 안에 연결을 확인하고, 실패 시 마지막 연결 오류를 dial 오류로 바로 반환
+// - ADMIN_DIAL_TIMEOUT_MS (기본 5000) 또는 WithDialTimeout 으로 조정, 0 이하이면 기존처럼 비동기 연결
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// 초기 연결 제한 시간 환경변수 이름 / 기본값(ms)
+	ENV_DIAL_TIMEOUT_MS = "ADMIN_DIAL_TIMEOUT_MS"
+	DIAL_TIMEOUT_MS     = 5000
+)
+
+// WithDialTimeout은 초기 연결 제한 시간을 지정합니다. 0 이하이면 연결 완료를 기다리지 않습니다.
+func WithDialTimeout(timeout time.Duration) AppOption {
+	return func(a *App) {
+		a.dialTimeout = timeout
+	}
+}
+
+// dialTimeoutFromEnv 환경변수의 초기 연결 제한 시간을 반환합니다.
+func dialTimeoutFromEnv() time.Duration {
+	return time.Duration(envInt(ENV_DIAL_TIMEOUT_MS, DIAL_TIMEOUT_MS)) * time.Millisecond
+}
+
+// dial 제한 시간 안에 서버 연결을 확인합니다. 제한 시간이 없으면 비동기로 연결합니다.
+func (a *App) dial(addr string, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	if a.dialTimeout <= 0 {
+		return grpc.Dial(addr, opts...)
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, a.dialTimeout)
+	defer cancel()
+	// WithReturnConnectionError: 제한 시간 초과 시 context 오류 대신 마지막 연결 오류를 함께 반환 (WithBlock 포함)
+	opts = append(opts[:len(opts):len(opts)], grpc.WithReturnConnectionError())
+	return grpc.DialContext(ctx, addr, opts...)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+// closedAddr 리슨을 열었다 닫아 연결이 거부되는 주소를 반환합니다.
+func closedAddr(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+	return addr
+}
+
+func TestDialClosedPortFailsWithinTimeout(t *testing.T) {
+	const timeout = 300 * time.Millisecond
+	app, _ := newTestApp(WithDialTimeout(timeout))
+	app.tls = tlsSettings{Insecure: true}
+	app.ctx = context.Background()
+	opts, err := app.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	conn, err := app.dial(closedAddr(t), opts)
+	elapsed := time.Since(start)
+	if err == nil {
+		conn.Close()
+		t.Fatal("닫힌 포트 연결이 성공함")
+	}
+	if elapsed > timeout+time.Second {
+		t.Fatalf("연결 오류까지 %v, want 제한 시간 %v 안팎", elapsed, timeout)
+	}
+}
+
+func TestDialWithoutTimeoutIsLazy(t *testing.T) {
+	app, _ := newTestApp(WithDialTimeout(0))
+	app.tls = tlsSettings{Insecure: true}
+	app.ctx = context.Background()
+	opts, err := app.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 제한 시간이 없으면 연결 확인 없이 바로 반환 (실패는 첫 RPC 에서 드러남)
+	conn, err := app.dial(closedAddr(t), opts)
+	if err != nil {
+		t.Fatalf("비동기 연결 오류 = %v, want nil", err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,140 @@
+package main
+
+// Overview 프레임 인코딩 오프로드
+// - 수신 고루틴에서 base64 인코딩 + 캐시 + 발행까지 하면 큰 프레임이 몰릴 때 Recv 가 늦어져 서버 쪽에 적체가 생김
+// - 수신 루프는 프레임을 Agent 별 최신 슬롯에 넣기만 하고, 작은 워커 풀이 인코딩/캐시/발행을 수행
+// - Agent 는 해시로 항상 같은 워커에 배정되어 Agent 내 처리 순서가 유지됨
+// - 워커가 밀리면 아직 처리 전인 이전 프레임은 새 프레임(오프라인 신호 포함)으로 교체 (대기열 크기 = Agent 수로 제한)
+// - AddServer 로 추가한 서버의 프레임도 같은 워커를 사용하며, 슬롯은 서버 주소 + agentId 로 구분
+
+import (
+	"encoding/base64"
+	"hash/fnv"
+	"sync"
+
+	"admin/proto"
+)
+
+const (
+	// Overview 인코딩 워커 수
+	OVERVIEW_ENCODE_WORKERS = 4
+)
+
+// encodeJob은 처리 대기 중인 프레임과 보낸 서버 주소입니다.
+// sc 는 추가 서버에서 받은 프레임일 때 해당 연결이며, 기본 서버면 nil 입니다.
+type encodeJob struct {
+	frame  *proto.FrameData
+	server string
+	sc     *serverConnection
+}
+
+// encodeShard는 워커 하나가 담당하는 Agent 별 최신 프레임 슬롯입니다.
+type encodeShard struct {
+	mu      sync.Mutex
+	pending map[string]encodeJob
+	order   []string // 대기 중인 슬롯(서버 + Agent)의 최초 도착 순서
+	notify  chan struct{}
+}
+
+// frameEncoder는 Agent 해시로 나눈 인코딩 대기열입니다.
+type frameEncoder struct {
+	shards []*encodeShard
+}
+
+// newFrameEncoder는 workers 개의 대기열을 가진 frameEncoder 를 생성합니다.
+func newFrameEncoder(workers int) *frameEncoder {
+	e := &frameEncoder{shards: make([]*encodeShard, workers)}
+	for i := range e.shards {
+		e.shards[i] = &encodeShard{
+			pending: make(map[string]encodeJob),
+			notify:  make(chan struct{}, 1),
+		}
+	}
+	return e
+}
+
+// put 프레임을 담당 워커의 슬롯에 넣습니다. 처리 전 프레임이 있으면 교체합니다.
+func (e *frameEncoder) put(job encodeJob) {
+	key := job.server + SERVER_EMIT_KEY_SEPARATOR + job.frame.GetAgentId()
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	shard := e.shards[h.Sum32()%uint32(len(e.shards))]
+	shard.mu.Lock()
+	if _, ok := shard.pending[key]; !ok {
+		shard.order = append(shard.order, key)
+	}
+	shard.pending[key] = job
+	shard.mu.Unlock()
+	select {
+	case shard.notify <- struct{}{}:
+	default:
+	}
+}
+
+// drain 대기 중인 프레임을 도착 순서대로 모두 꺼냅니다.
+func (s *encodeShard) drain() []encodeJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]encodeJob, 0, len(s.order))
+	for _, key := range s.order {
+		jobs = append(jobs, s.pending[key])
+		delete(s.pending, key)
+	}
+	s.order = s.order[:0]
+	return jobs
+}
+
+// startEncodeWorkers 인코딩 워커를 시작합니다. a.ctx 취소 시 종료합니다.
+func (a *App) startEncodeWorkers() {
+	for _, shard := range a.encoder.shards {
+		go a.encodeLoop(shard)
+	}
+}
+
+// encodeLoop 담당 슬롯의 프레임을 인코딩해 캐시/발행합니다.
+func (a *App) encodeLoop(shard *encodeShard) {
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-shard.notify:
+			for _, job := range shard.drain() {
+				a.processOverviewFrame(job)
+			}
+		}
+	}
+}
+
+// processOverviewFrame 수신 프레임 하나를 캐시하고 프론트로 발행합니다.
+func (a *App) processOverviewFrame(job encodeJob) {
+	frame, server := job.frame, job.server
+	if job.sc != nil {
+		select {
+		case <-job.sc.done:
+			// RemoveServer 이후 남은 프레임은 버림
+			return
+		default:
+		}
+		snap, payload := a.overviewFrameOutput(frame, server)
+		job.sc.store(snap)
+		a.emitOverview(server+SERVER_EMIT_KEY_SEPARATOR+frame.GetAgentId(), payload)
+		return
+	}
+	if isOfflineFrame(frame) {
+		a.handleOfflineFrame(frame, server)
+		return
+	}
+	snap, payload := a.overviewFrameOutput(frame, server)
+	a.storeSnapshot(frame, snap, server)
+	a.emitOverview(frame.GetAgentId(), payload)
+}
+
+// overviewFrameOutput 발행 모드(raw/base64)에 맞춰 캐시 스냅샷과 이벤트 payload 를 만듭니다.
+// 이미지가 없는 상태 신호 프레임은 raw 모드에서도 빈 base64 로 전달합니다.
+func (a *App) overviewFrameOutput(frame *proto.FrameData, server string) (*frameSnapshot, map[string]any) {
+	if a.rawFrameEmit.Load() && len(frame.GetImageData()) > 0 {
+		return newRawFrameSnapshot(frame, server), rawFrameEventPayload(frame, server)
+	}
+	bs := base64.StdEncoding.EncodeToString(frame.GetImageData())
+	return newFrameSnapshot(frame, bs, server), frameEventPayload(frame, bs, server)
+}
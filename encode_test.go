@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+const (
+	// 벤치마크 프레임 크기 (큰 원본 프레임 가정)
+	BENCH_FRAME_BYTES = 256 * 1024
+	// 벤치마크 Agent 수
+	BENCH_AGENTS = 16
+)
+
+func TestFrameEncoderCoalescesPerAgent(t *testing.T) {
+	e := newFrameEncoder(1)
+	for i := range 3 {
+		e.put(encodeJob{frame: &proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}}, server: "srv"})
+	}
+	e.put(encodeJob{frame: &proto.FrameData{AgentId: "agent-2", ImageData: []byte("b")}, server: "srv"})
+	// 같은 Agent 라도 서버가 다르면 별도 슬롯
+	e.put(encodeJob{frame: &proto.FrameData{AgentId: "agent-1", ImageData: []byte("other")}, server: "srv-2"})
+
+	jobs := e.shards[0].drain()
+	if len(jobs) != 3 {
+		t.Fatalf("대기 프레임 = %d, want 3", len(jobs))
+	}
+	// 최초 도착 순서를 유지하고 슬롯에는 최신 프레임만 남음
+	if jobs[0].frame.GetAgentId() != "agent-1" || jobs[0].frame.GetImageData()[0] != 2 {
+		t.Fatalf("첫 슬롯 = %v, want agent-1 최신 프레임", jobs[0].frame)
+	}
+	if jobs[1].frame.GetAgentId() != "agent-2" || jobs[2].server != "srv-2" {
+		t.Fatalf("슬롯 순서 = %v %v", jobs[1].frame.GetAgentId(), jobs[2].server)
+	}
+	if len(e.shards[0].drain()) != 0 {
+		t.Fatal("drain 후에도 대기 프레임이 남음")
+	}
+}
+
+// BenchmarkOverviewRecvPath는 수신 고루틴이 프레임 하나를 넘기는 데 걸리는 시간을 비교합니다.
+// inline 은 수신 고루틴에서 직접 인코딩/캐시/발행하고, offload 는 워커 슬롯에 넣기만 합니다.
+func BenchmarkOverviewRecvPath(b *testing.B) {
+	image := make([]byte, BENCH_FRAME_BYTES)
+	frames := make([]*proto.FrameData, BENCH_AGENTS)
+	for i := range frames {
+		frames[i] = &proto.FrameData{AgentId: fmt.Sprintf("agent-%d", i), ImageData: image, Timestamp: 1000}
+	}
+	newApp := func() *App {
+		app := NewApp(WithEventEmitter(func(string, any) {}))
+		app.SetOverviewEmitRate(0)
+		return app
+	}
+
+	b.Run("inline", func(b *testing.B) {
+		app := newApp()
+		server := app.serverAddress()
+		b.SetBytes(BENCH_FRAME_BYTES)
+		b.ResetTimer()
+		for i := range b.N {
+			app.processOverviewFrame(encodeJob{frame: frames[i%BENCH_AGENTS], server: server})
+		}
+	})
+	b.Run("offload", func(b *testing.B) {
+		app := newApp()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		app.ctx = ctx
+		app.startEncodeWorkers()
+		server := app.serverAddress()
+		b.SetBytes(BENCH_FRAME_BYTES)
+		b.ResetTimer()
+		for i := range b.N {
+			app.encoder.put(encodeJob{frame: frames[i%BENCH_AGENTS], server: server})
+		}
+	})
+}
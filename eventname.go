@@ -0,0 +1,71 @@
+package main
+
+// 프론트 이벤트 이름 네임스페이스
+// - 여러 App 인스턴스/뷰가 같은 런타임을 공유할 때 이벤트 이름 충돌을 피하도록 접두사를 붙임
+// - 접두사가 설정되면 "<prefix>:overviewFrame", "<prefix>:connectionStatus" 형태로 발행
+// - 기본값(빈 접두사)은 기존 이벤트 이름 그대로 유지
+// - ADMIN_EVENT_PREFIX 환경변수 또는 SetEventPrefix 로 설정
+// - WithEventEmitter 로 발행 함수를 주입하면 Wails 런타임 대신 사용 (테스트/헤드리스 실행)
+
+import (
+	"os"
+	"sync/atomic"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const (
+	// 이벤트 이름 접두사 환경변수 이름
+	ENV_EVENT_PREFIX = "ADMIN_EVENT_PREFIX"
+	// 접두사와 이벤트 이름 구분자
+	EVENT_PREFIX_SEPARATOR = ":"
+)
+
+// eventPrefix는 이벤트 이름 접두사입니다. (emit 경로에서 잠금 없이 읽도록 atomic 사용)
+type eventPrefix struct {
+	value atomic.Value // string
+}
+
+// newEventPrefix는 환경변수 값으로 초기화된 eventPrefix 를 생성합니다.
+func newEventPrefix() *eventPrefix {
+	p := &eventPrefix{}
+	p.value.Store(os.Getenv(ENV_EVENT_PREFIX))
+	return p
+}
+
+// apply 접두사를 붙인 이벤트 이름을 반환합니다.
+func (p *eventPrefix) apply(name string) string {
+	prefix, _ := p.value.Load().(string)
+	if prefix == "" {
+		return name
+	}
+	return prefix + EVENT_PREFIX_SEPARATOR + name
+}
+
+// SetEventPrefix 이후 발행되는 모든 이벤트 이름의 접두사를 설정합니다. 빈 문자열이면 기본 이름을 사용합니다.
+func (a *App) SetEventPrefix(prefix string) {
+	a.eventPrefix.value.Store(prefix)
+}
+
+// GetEventPrefix 현재 이벤트 이름 접두사를 반환합니다.
+func (a *App) GetEventPrefix() string {
+	prefix, _ := a.eventPrefix.value.Load().(string)
+	return prefix
+}
+
+// WithEventEmitter는 프론트 이벤트를 Wails 런타임 대신 fn 으로 발행합니다. (접두사 적용 후 이름 전달)
+func WithEventEmitter(fn func(name string, data any)) AppOption {
+	return func(a *App) {
+		a.emitter = fn
+	}
+}
+
+// emit 접두사를 적용하여 프론트로 이벤트를 발행합니다.
+func (a *App) emit(name string, data any) {
+	name = a.eventPrefix.apply(name)
+	if a.emitter != nil {
+		a.emitter(name, data)
+		return
+	}
+	runtime.EventsEmit(a.ctx, name, data)
+}
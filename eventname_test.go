@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestEventPrefixAppliedToEmittedNames(t *testing.T) {
+	app, rec := newTestApp()
+	app.emitStreamStatus(STREAM_KIND_DETAIL, "agent-1", STREAM_STATE_CLOSED, nil)
+	if len(rec.named(EVENT_STREAM_STATUS)) != 1 {
+		t.Fatalf("기본 접두사에서 %q 이벤트가 발행되지 않음", EVENT_STREAM_STATUS)
+	}
+
+	app.SetEventPrefix("region-east")
+	if got := app.GetEventPrefix(); got != "region-east" {
+		t.Fatalf("GetEventPrefix = %q", got)
+	}
+	app.emitStreamStatus(STREAM_KIND_DETAIL, "agent-1", STREAM_STATE_CLOSED, nil)
+	if got := len(rec.named("region-east:" + EVENT_STREAM_STATUS)); got != 1 {
+		t.Fatalf("접두사 적용 이벤트 수 = %d, want 1", got)
+	}
+	if got := len(rec.named(EVENT_STREAM_STATUS)); got != 1 {
+		t.Fatalf("접두사 설정 후 기본 이름 이벤트 수 = %d, want 1", got)
+	}
+}
+
+func TestEventPrefixFromEnvironment(t *testing.T) {
+	t.Setenv(ENV_EVENT_PREFIX, "view-2")
+	app, rec := newTestApp()
+	app.emitStreamStatus(STREAM_KIND_EVENTS, "agent-1", STREAM_STATE_CLOSED, nil)
+	if got := len(rec.named("view-2:" + EVENT_STREAM_STATUS)); got != 1 {
+		t.Fatalf("환경변수 접두사 이벤트 수 = %d, want 1", got)
+	}
+}
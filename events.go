@@ -0,0 +1,112 @@
+package main
+
+// Events 스트림 브리지
+// - StartEvents/StopEvents 로 특정 Agent 의 이벤트 구독을 열고 닫음
+// - 수신 이벤트는 agentEvent:<agentId> 이벤트로 프론트에 전달
+// - 스트림 오류 시 streamStatus 이벤트를 발행하고, 재연결은 외부 bootstrapLoop 에 맡김
+// - StopEvents 전까지는 구독 의사(eventsWanted)를 유지하여 재연결 후 다시 구독
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"admin/proto"
+)
+
+const (
+	// Agent 이벤트 이름 접두사 (뒤에 agentId 가 붙음)
+	EVENT_AGENT_EVENT_PREFIX = "agentEvent:"
+	// 전체 Agent 구독용 agentId (서버가 각 이벤트에 실제 agentId 를 채워 보냄)
+	WILDCARD_AGENT_ID = "*"
+)
+
+// StartEvents 특정 Agent 의 이벤트 스트림을 시작합니다. 이미 실행 중이면 아무것도 하지 않습니다.
+func (a *App) StartEvents(agentId string) error {
+	if agentId == "" {
+		return errors.New("agentId is required")
+	}
+	client := a.client()
+	if client == nil {
+		return errors.New("not connected to server")
+	}
+	_, err := a.openEvents(client, agentId, false)
+	return err
+}
+
+// openEvents 이벤트 스트림 슬롯을 예약한 뒤 eventsMu 밖에서 스트림을 열고 수신 고루틴을 시작합니다.
+// 이미 실행(또는 여는) 중이거나, onlyWanted 인데 구독 의사가 없으면(StopEvents 됨) false 를 반환합니다.
+func (a *App) openEvents(client proto.AdminServiceClient, agentId string, onlyWanted bool) (bool, error) {
+	a.eventsMu.Lock()
+	h, ctx, wasWanted := a.reserveStream(a.eventStreams, a.eventsWanted, agentId, onlyWanted)
+	a.eventsMu.Unlock()
+	if h == nil {
+		return false, nil
+	}
+	stream, err := client.SubscribeEvents(ctx, &proto.AgentDetailRequest{AdminId: a.adminID, AgentId: agentId})
+	if err != nil {
+		releaseStream(&a.eventsMu, a.eventStreams, a.eventsWanted, agentId, h, wasWanted)
+		return false, fmt.Errorf("subscribe events: %w", err)
+	}
+	go a.recvEvents(agentId, stream, h)
+	log.Printf("[Admin][STREAM] events(%s) 구독 시작", agentId)
+	return true, nil
+}
+
+// StopEvents 특정 Agent 의 이벤트 스트림을 취소합니다. 실행 중이 아니면 아무것도 하지 않습니다.
+func (a *App) StopEvents(agentId string) {
+	a.eventsMu.Lock()
+	h, ok := a.eventStreams[agentId]
+	delete(a.eventStreams, agentId)
+	delete(a.eventsWanted, agentId)
+	a.eventsMu.Unlock()
+	if !ok {
+		return
+	}
+	h.cancel()
+	<-h.done
+	log.Printf("[Admin][STREAM] events(%s) 구독 중지", agentId)
+}
+
+// recvEvents 이벤트 스트림을 수신하여 프론트 이벤트로 전파합니다.
+func (a *App) recvEvents(agentId string, stream proto.AdminService_SubscribeEventsClient, h *streamHandle) {
+	defer close(h.done)
+	defer func() {
+		a.eventsMu.Lock()
+		if a.eventStreams[agentId] == h {
+			delete(a.eventStreams, agentId)
+		}
+		a.eventsMu.Unlock()
+		h.cancel()
+	}()
+	eventName := EVENT_AGENT_EVENT_PREFIX + agentId
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			log.Printf("[Admin][STREAM] events(%s) 수신 종료: %v", agentId, err)
+			// 사용자가 중지한 경우(context 취소)는 오류로 알리지 않음
+			if stream.Context().Err() == nil {
+				a.emitStreamStatus(STREAM_KIND_EVENTS, agentId, STREAM_STATE_CLOSED, err)
+			}
+			return
+		}
+		if event.GetEventType() == HEARTBEAT_EVENT_TYPE {
+			continue
+		}
+		// Agent ID 없는 이벤트는 구독 대상으로 보정하고, 전체 구독이라 알 수 없으면 버림
+		eventAgentId := event.GetAgentId()
+		if eventAgentId == "" && agentId != WILDCARD_AGENT_ID {
+			eventAgentId = agentId
+		}
+		if eventAgentId == "" {
+			log.Printf("[Admin][STREAM] events(%s) agentId 없는 이벤트 무시: type=%q", agentId, event.GetEventType())
+			continue
+		}
+		a.emit(eventName, map[string]any{
+			"agentId":     eventAgentId,
+			"eventType":   event.GetEventType(),
+			"eventDetail": event.GetEventDetail(),
+			"timestamp":   event.GetTimestamp(),
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"admin/proto"
+
+	"google.golang.org/grpc"
+)
+
+// fakeEventsClient는 정해진 이벤트를 차례로 돌려주고 끝나면 io.EOF 를 반환하는 Events 수신 스트림입니다.
+type fakeEventsClient struct {
+	grpc.ClientStream
+	events []*proto.EventData
+}
+
+func (f *fakeEventsClient) Recv() (*proto.EventData, error) {
+	if len(f.events) == 0 {
+		return nil, io.EOF
+	}
+	event := f.events[0]
+	f.events = f.events[1:]
+	return event, nil
+}
+
+func (f *fakeEventsClient) Context() context.Context { return context.Background() }
+
+// recvTestEvents agentId 구독으로 events 를 수신 처리하고 발행된 Agent 이벤트 payload 를 반환합니다.
+func recvTestEvents(agentId string, events ...*proto.EventData) []any {
+	app, rec := newTestApp()
+	h := &streamHandle{cancel: func() {}, done: make(chan struct{})}
+	app.recvEvents(agentId, &fakeEventsClient{events: events}, h)
+	return rec.named(EVENT_AGENT_EVENT_PREFIX + agentId)
+}
+
+func TestRecvEventsDropsMalformed(t *testing.T) {
+	got := recvTestEvents(WILDCARD_AGENT_ID,
+		nil,
+		&proto.EventData{EventType: HEARTBEAT_EVENT_TYPE},
+		&proto.EventData{EventType: "usb"},
+		&proto.EventData{AgentId: "agent-2", EventType: "usb"},
+	)
+	// 전체 구독에서 Agent 를 알 수 없는 이벤트는 버림
+	if len(got) != 1 || payloadAgentId(got[0]) != "agent-2" {
+		t.Fatalf("전체 구독 발행 = %v, want agent-2 이벤트 1 개", got)
+	}
+}
+
+func TestRecvEventsFillsSubscribedAgent(t *testing.T) {
+	got := recvTestEvents("agent-1", &proto.EventData{EventType: "usb"})
+	if len(got) != 1 || payloadAgentId(got[0]) != "agent-1" {
+		t.Fatalf("개별 구독 발행 = %v, want agent-1 로 보정된 이벤트", got)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+// Overview 포커스 Agent
+// - 크게 보는 타일 하나를 위해 Detail 스트림을 따로 열지 않고, Overview 스트림에서 해당 Agent 만 원본 해상도로 받음
+// - 포커스 Agent 는 overviewMu 로 보관해 재연결 후 구독 요청(focus_agent_id)에 다시 실림
+// - 연결 중이면 SetOverviewFocus RPC 로 실행 중인 구독에 즉시 반영 (구버전 서버는 Unimplemented)
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetOverviewFocus Overview 스트림에서 원본 해상도로 받을 Agent 를 지정합니다. 빈 문자열이면 해제합니다.
+// 연결 전이면 다음 구독부터 적용됩니다.
+func (a *App) SetOverviewFocus(agentId string) error {
+	a.overviewMu.Lock()
+	a.overviewFocus = agentId
+	a.overviewMu.Unlock()
+	client := a.client()
+	if client == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	_, err := client.SetOverviewFocus(ctx, &proto.OverviewFocusRequest{
+		AdminId:        a.adminID,
+		SubscriptionId: OVERVIEW_SUBSCRIPTION_ID,
+		FocusAgentId:   agentId,
+	})
+	if status.Code(err) == codes.NotFound {
+		// Overview 를 꺼 두었거나 재구독 중이면 다음 구독 요청에 실려 적용됨
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("set overview focus: %w", err)
+	}
+	log.Printf("[Admin][RPC] overview 포커스 변경: %q", agentId)
+	return nil
+}
+
+// GetOverviewFocus 현재 포커스 Agent 를 반환합니다. 없으면 빈 문자열입니다.
+func (a *App) GetOverviewFocus() string {
+	a.overviewMu.Lock()
+	defer a.overviewMu.Unlock()
+	return a.overviewFocus
+}
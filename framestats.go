@@ -0,0 +1,81 @@
+package main
+
+// 프레임 수신 통계
+// - Agent 별 수신 프레임 수와, 타임스탬프 간격으로 추정한 누락(gap) 횟수를 집계
+// - 평소 간격(EWMA)보다 크게 벌어진 간격을 누락으로 판단 (피드 저하 vs 단순 유휴 구분용)
+// - 주기적으로 frameStats 이벤트를 프론트로 발행
+
+import "time"
+
+const (
+	// 프레임 통계 이벤트 이름 및 발행 간격
+	EVENT_FRAME_STATS       = "frameStats"
+	FRAME_STATS_INTERVAL_MS = 5000
+	// 평소 간격 대비 이 배수 이상 벌어지면 누락으로 판단
+	FRAME_GAP_FACTOR = 2.5
+	// 평소 간격 EWMA 가중치
+	FRAME_INTERVAL_EWMA_ALPHA = 0.2
+)
+
+// agentFrameStats는 Agent 별 프레임 수신 통계입니다.
+type agentFrameStats struct {
+	Received int64 `json:"received"`
+	Gaps     int64 `json:"gaps"`
+	// 직전 프레임 타임스탬프와 평소 간격 추정치 (ms)
+	lastTimestamp int64
+	avgInterval   float64
+}
+
+// observe 프레임 타임스탬프를 반영합니다. 상태 신호 프레임은 호출하지 않습니다.
+func (st *agentFrameStats) observe(timestamp int64) {
+	st.Received++
+	if st.lastTimestamp > 0 && timestamp > st.lastTimestamp {
+		interval := float64(timestamp - st.lastTimestamp)
+		if st.avgInterval > 0 && interval > st.avgInterval*FRAME_GAP_FACTOR {
+			st.Gaps++
+		} else if st.avgInterval == 0 {
+			st.avgInterval = interval
+		} else {
+			// 누락 간격은 평소 간격 추정에 반영하지 않음
+			st.avgInterval += FRAME_INTERVAL_EWMA_ALPHA * (interval - st.avgInterval)
+		}
+	}
+	if timestamp > st.lastTimestamp {
+		st.lastTimestamp = timestamp
+	}
+}
+
+// recordFrameStats 프레임 통계를 갱신합니다. framesMu 를 잡은 상태에서 호출합니다.
+func (a *App) recordFrameStats(agentId string, timestamp int64) {
+	st, ok := a.frameStats[agentId]
+	if !ok {
+		st = &agentFrameStats{}
+		a.frameStats[agentId] = st
+	}
+	st.observe(timestamp)
+}
+
+// GetFrameStats Agent 별 프레임 수신 통계를 반환합니다.
+func (a *App) GetFrameStats() map[string]agentFrameStats {
+	a.framesMu.RLock()
+	defer a.framesMu.RUnlock()
+	out := make(map[string]agentFrameStats, len(a.frameStats))
+	for agentId, st := range a.frameStats {
+		out[agentId] = *st
+	}
+	return out
+}
+
+// frameStatsLoop 주기적으로 frameStats 이벤트를 발행합니다.
+func (a *App) frameStatsLoop() {
+	ticker := time.NewTicker(FRAME_STATS_INTERVAL_MS * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.emit(EVENT_FRAME_STATS, a.GetFrameStats())
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestFrameStatsDetectsGaps(t *testing.T) {
+	var st agentFrameStats
+	// 100ms 간격 후 1초 공백, 다시 100ms 간격
+	for _, ts := range []int64{1000, 1100, 1200, 1300, 2300, 2400, 2500} {
+		st.observe(ts)
+	}
+	if st.Received != 7 || st.Gaps != 1 {
+		t.Fatalf("통계 = %+v, want received 7 gaps 1", st)
+	}
+	// 누락 간격은 평소 간격 추정에 반영되지 않음
+	if st.avgInterval < 90 || st.avgInterval > 110 {
+		t.Fatalf("평소 간격 추정 = %.1f, want ~100", st.avgInterval)
+	}
+}
+
+func TestFrameStatsIgnoresOutOfOrder(t *testing.T) {
+	var st agentFrameStats
+	for _, ts := range []int64{1000, 1100, 1050, 1200} {
+		st.observe(ts)
+	}
+	if st.Gaps != 0 || st.lastTimestamp != 1200 {
+		t.Fatalf("통계 = %+v, want gaps 0 last 1200", st)
+	}
+}
+
+func TestGetFrameStatsPerAgent(t *testing.T) {
+	app, _ := newTestApp()
+	for _, ts := range []int64{1000, 1100, 1200, 2000} {
+		storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: ts})
+	}
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-2", ImageData: []byte("img"), Timestamp: 1000})
+	// 오프라인 신호는 집계하지 않음
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-2", Offline: true})
+
+	stats := app.GetFrameStats()
+	if got := stats["agent-1"]; got.Received != 4 || got.Gaps != 1 {
+		t.Fatalf("agent-1 통계 = %+v, want received 4 gaps 1", got)
+	}
+	if got := stats["agent-2"]; got.Received != 1 || got.Gaps != 0 {
+		t.Fatalf("agent-2 통계 = %+v, want received 1 gaps 0", got)
+	}
+}
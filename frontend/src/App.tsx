@@ -16,8 +16,13 @@ const DETAIL_ASPECT_RATIO = '16/9'
 interface OverviewFrameData {
     agentId: string
     imageBase64: string
+    // raw 모드(SetRawFrameEmit)에서는 imageBase64 대신 채워짐 (AssetServer 가 바이트를 그대로 응답하는 주소)
+    imageUrl?: string
     isPreview: boolean
     timestamp: number
+    // 클라이언트가 판별한 오프라인 여부 (구버전 클라이언트는 누락 가능)
+    offline?: boolean
+    status?: string
 }
 
 // Wails Events API (런타임 전역)
@@ -27,6 +32,9 @@ declare global {
     }
 }
 
+// 프레임 이미지 src (raw 모드는 imageUrl, 기본은 base64 data URL)
+const frameImageSrc = (f: OverviewFrameData) => f.imageUrl || `data:image/jpeg;base64,${f.imageBase64}`
+
 // 간단한 시간 포맷터
 const formatTime = (ts: number) => {
     const d = new Date(ts)
@@ -42,7 +50,7 @@ function App() {
         // 이벤트 수신 핸들러 (오프라인 프레임 → 제거)
         const handler = (data: OverviewFrameData) => {
             setFrames(prev => {
-                const isOffline = data.timestamp === OFFLINE_TIMESTAMP && !data.imageBase64
+                const isOffline = data.offline ?? (data.timestamp === OFFLINE_TIMESTAMP && !data.imageBase64 && !data.imageUrl)
                 if (isOffline) {
                     const next = {...prev}
                     delete next[data.agentId]
@@ -102,9 +110,9 @@ function App() {
                         padding: 12
                     }}
                 >
-                    {selectedFrame.imageBase64 ? (
+                    {(selectedFrame.imageBase64 || selectedFrame.imageUrl) ? (
                         <img
-                            src={`data:image/jpeg;base64,${selectedFrame.imageBase64}`}
+                            src={frameImageSrc(selectedFrame)}
                             style={{
                                 width: '100%',
                                 aspectRatio: DETAIL_ASPECT_RATIO,
@@ -181,9 +189,9 @@ function App() {
                             디테일
                         </button>
                     </div>
-                    {f.imageBase64 ? (
+                    {(f.imageBase64 || f.imageUrl) ? (
                         <img
-                            src={`data:image/jpeg;base64,${f.imageBase64}`}
+                            src={frameImageSrc(f)}
                             style={{
                                 width: '100%',
                                 aspectRatio: PREVIEW_ASPECT_RATIO,
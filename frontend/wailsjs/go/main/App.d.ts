@@ -2,6 +2,86 @@
 // This file is automatically generated. DO NOT EDIT
 import {main} from '../models';
 
+export function AddServer(arg1:string):Promise<void>;
+
+export function GetAgentMetadata():Promise<{[key: string]: main.agentMetadata}>;
+
+export function GetAgents():Promise<Array<main.agentSummary>>;
+
+export function GetBackoffState():Promise<main.backoffState>;
+
+export function GetConnectionStatus():Promise<main.connectionStatus>;
+
+export function GetEventPrefix():Promise<string>;
+
+export function GetFrameStats():Promise<{[key: string]: main.agentFrameStats}>;
+
+export function GetLatestFrame(arg1:string):Promise<main.frameSnapshot>;
+
 export function GetLatestFrames():Promise<Array<main.frameSnapshot>>;
 
+export function GetLatestFramesFiltered(arg1:boolean):Promise<Array<main.frameSnapshot>>;
+
+export function GetOverviewFocus():Promise<string>;
+
+export function GetServerAddress():Promise<string>;
+
+export function GetServerHealth():Promise<main.serverHealth>;
+
+export function GetServers():Promise<Array<string>>;
+
 export function Greet(arg1:string):Promise<string>;
+
+export function IsOverviewEnabled():Promise<boolean>;
+
+export function IsOverviewPaused():Promise<boolean>;
+
+export function IsRawFrameEmit():Promise<boolean>;
+
+export function PauseOverview():Promise<void>;
+
+export function Ping():Promise<number>;
+
+export function PruneStaleFrames(arg1:number):Promise<number>;
+
+export function Reconnect():Promise<void>;
+
+export function RemoveServer(arg1:string):Promise<void>;
+
+export function ResumeOverview():Promise<void>;
+
+export function SaveFrame(arg1:string,arg2:string):Promise<void>;
+
+export function SetEventPrefix(arg1:string):Promise<void>;
+
+export function SetOfflineGrace(arg1:number):Promise<void>;
+
+export function SetOverviewEmitRate(arg1:number):Promise<void>;
+
+export function SetOverviewFocus(arg1:string):Promise<void>;
+
+export function SetRawFrameEmit(arg1:boolean):Promise<void>;
+
+export function SetReconnectPolicy(arg1:number,arg2:number,arg3:number):Promise<void>;
+
+export function SetServerAddress(arg1:string):Promise<void>;
+
+export function SetTLSConfig(arg1:main.tlsSettings):Promise<void>;
+
+export function StalledAgents(arg1:number):Promise<Array<string>>;
+
+export function StartDetail(arg1:string):Promise<void>;
+
+export function StartEvents(arg1:string):Promise<void>;
+
+export function StartOverview():Promise<void>;
+
+export function StartRecording(arg1:string,arg2:string):Promise<void>;
+
+export function StopDetail(arg1:string):Promise<void>;
+
+export function StopEvents(arg1:string):Promise<void>;
+
+export function StopOverview():Promise<void>;
+
+export function StopRecording(arg1:string):Promise<void>;
@@ -2,10 +2,170 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AddServer(arg1) {
+  return window['go']['main']['App']['AddServer'](arg1);
+}
+
+export function GetAgentMetadata() {
+  return window['go']['main']['App']['GetAgentMetadata']();
+}
+
+export function GetAgents() {
+  return window['go']['main']['App']['GetAgents']();
+}
+
+export function GetBackoffState() {
+  return window['go']['main']['App']['GetBackoffState']();
+}
+
+export function GetConnectionStatus() {
+  return window['go']['main']['App']['GetConnectionStatus']();
+}
+
+export function GetEventPrefix() {
+  return window['go']['main']['App']['GetEventPrefix']();
+}
+
+export function GetFrameStats() {
+  return window['go']['main']['App']['GetFrameStats']();
+}
+
+export function GetLatestFrame(arg1) {
+  return window['go']['main']['App']['GetLatestFrame'](arg1);
+}
+
 export function GetLatestFrames() {
   return window['go']['main']['App']['GetLatestFrames']();
 }
 
+export function GetLatestFramesFiltered(arg1) {
+  return window['go']['main']['App']['GetLatestFramesFiltered'](arg1);
+}
+
+export function GetOverviewFocus() {
+  return window['go']['main']['App']['GetOverviewFocus']();
+}
+
+export function GetServerAddress() {
+  return window['go']['main']['App']['GetServerAddress']();
+}
+
+export function GetServerHealth() {
+  return window['go']['main']['App']['GetServerHealth']();
+}
+
+export function GetServers() {
+  return window['go']['main']['App']['GetServers']();
+}
+
 export function Greet(arg1) {
   return window['go']['main']['App']['Greet'](arg1);
 }
+
+export function IsOverviewEnabled() {
+  return window['go']['main']['App']['IsOverviewEnabled']();
+}
+
+export function IsOverviewPaused() {
+  return window['go']['main']['App']['IsOverviewPaused']();
+}
+
+export function IsRawFrameEmit() {
+  return window['go']['main']['App']['IsRawFrameEmit']();
+}
+
+export function PauseOverview() {
+  return window['go']['main']['App']['PauseOverview']();
+}
+
+export function Ping() {
+  return window['go']['main']['App']['Ping']();
+}
+
+export function PruneStaleFrames(arg1) {
+  return window['go']['main']['App']['PruneStaleFrames'](arg1);
+}
+
+export function Reconnect() {
+  return window['go']['main']['App']['Reconnect']();
+}
+
+export function RemoveServer(arg1) {
+  return window['go']['main']['App']['RemoveServer'](arg1);
+}
+
+export function ResumeOverview() {
+  return window['go']['main']['App']['ResumeOverview']();
+}
+
+export function SaveFrame(arg1, arg2) {
+  return window['go']['main']['App']['SaveFrame'](arg1, arg2);
+}
+
+export function SetEventPrefix(arg1) {
+  return window['go']['main']['App']['SetEventPrefix'](arg1);
+}
+
+export function SetOfflineGrace(arg1) {
+  return window['go']['main']['App']['SetOfflineGrace'](arg1);
+}
+
+export function SetOverviewEmitRate(arg1) {
+  return window['go']['main']['App']['SetOverviewEmitRate'](arg1);
+}
+
+export function SetOverviewFocus(arg1) {
+  return window['go']['main']['App']['SetOverviewFocus'](arg1);
+}
+
+export function SetRawFrameEmit(arg1) {
+  return window['go']['main']['App']['SetRawFrameEmit'](arg1);
+}
+
+export function SetReconnectPolicy(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetReconnectPolicy'](arg1, arg2, arg3);
+}
+
+export function SetServerAddress(arg1) {
+  return window['go']['main']['App']['SetServerAddress'](arg1);
+}
+
+export function SetTLSConfig(arg1) {
+  return window['go']['main']['App']['SetTLSConfig'](arg1);
+}
+
+export function StalledAgents(arg1) {
+  return window['go']['main']['App']['StalledAgents'](arg1);
+}
+
+export function StartDetail(arg1) {
+  return window['go']['main']['App']['StartDetail'](arg1);
+}
+
+export function StartEvents(arg1) {
+  return window['go']['main']['App']['StartEvents'](arg1);
+}
+
+export function StartOverview() {
+  return window['go']['main']['App']['StartOverview']();
+}
+
+export function StartRecording(arg1, arg2) {
+  return window['go']['main']['App']['StartRecording'](arg1, arg2);
+}
+
+export function StopDetail(arg1) {
+  return window['go']['main']['App']['StopDetail'](arg1);
+}
+
+export function StopEvents(arg1) {
+  return window['go']['main']['App']['StopEvents'](arg1);
+}
+
+export function StopOverview() {
+  return window['go']['main']['App']['StopOverview']();
+}
+
+export function StopRecording(arg1) {
+  return window['go']['main']['App']['StopRecording'](arg1);
+}
@@ -1,10 +1,114 @@
 export namespace main {
 	
+	export class agentFrameStats {
+	    received: number;
+	    gaps: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new agentFrameStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.received = source["received"];
+	        this.gaps = source["gaps"];
+	    }
+	}
+	
+	export class agentMetadata {
+	    name: string;
+	    tags: {[key: string]: string};
+	
+	    static createFrom(source: any = {}) {
+	        return new agentMetadata(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.tags = source["tags"];
+	    }
+	}
+	
+	export class agentSummary {
+	    agentId: string;
+	    lastFrameTimestamp: number;
+	    lastSeen: number;
+	    offline: boolean;
+	    name: string;
+	    tags: {[key: string]: string};
+	    online: boolean;
+	    lastError: string;
+	    fps: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new agentSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.agentId = source["agentId"];
+	        this.lastFrameTimestamp = source["lastFrameTimestamp"];
+	        this.lastSeen = source["lastSeen"];
+	        this.offline = source["offline"];
+	        this.name = source["name"];
+	        this.tags = source["tags"];
+	        this.online = source["online"];
+	        this.lastError = source["lastError"];
+	        this.fps = source["fps"];
+	    }
+	}
+	
+	export class backoffState {
+	    attempt: number;
+	    delayMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new backoffState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attempt = source["attempt"];
+	        this.delayMs = source["delayMs"];
+	    }
+	}
+	
+	export class connectionStatus {
+	    state: string;
+	    serverAddress: string;
+	    retryCount: number;
+	    lastError: string;
+	    terminal: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new connectionStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = source["state"];
+	        this.serverAddress = source["serverAddress"];
+	        this.retryCount = source["retryCount"];
+	        this.lastError = source["lastError"];
+	        this.terminal = source["terminal"];
+	    }
+	}
+	
 	export class frameSnapshot {
 	    agentId: string;
 	    imageBase64: string;
+	    imageUrl: string;
+	    encoding: string;
 	    isPreview: boolean;
 	    timestamp: number;
+	    offline: boolean;
+	    receivedAt: number;
+	    server: string;
+	    status: string;
+	    statusMessage: string;
+	    name: string;
+	    tags: {[key: string]: string};
 	
 	    static createFrom(source: any = {}) {
 	        return new frameSnapshot(source);
@@ -14,8 +118,59 @@ export namespace main {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.agentId = source["agentId"];
 	        this.imageBase64 = source["imageBase64"];
+	        this.imageUrl = source["imageUrl"];
+	        this.encoding = source["encoding"];
 	        this.isPreview = source["isPreview"];
 	        this.timestamp = source["timestamp"];
+	        this.offline = source["offline"];
+	        this.receivedAt = source["receivedAt"];
+	        this.server = source["server"];
+	        this.status = source["status"];
+	        this.statusMessage = source["statusMessage"];
+	        this.name = source["name"];
+	        this.tags = source["tags"];
+	    }
+	}
+	
+	export class serverHealth {
+	    serving: boolean;
+	    overviewSubscribers: number;
+	    detailSubscribers: number;
+	    eventSubscribers: number;
+	    uptimeMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new serverHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.serving = source["serving"];
+	        this.overviewSubscribers = source["overviewSubscribers"];
+	        this.detailSubscribers = source["detailSubscribers"];
+	        this.eventSubscribers = source["eventSubscribers"];
+	        this.uptimeMs = source["uptimeMs"];
+	    }
+	}
+	
+	export class tlsSettings {
+	    caFile: string;
+	    certFile: string;
+	    keyFile: string;
+	    serverName: string;
+	    insecure: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new tlsSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.caFile = source["caFile"];
+	        this.certFile = source["certFile"];
+	        this.keyFile = source["keyFile"];
+	        this.serverName = source["serverName"];
+	        this.insecure = source["insecure"];
 	    }
 	}
 
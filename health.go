@@ -0,0 +1,112 @@
+package main
+
+// 서버 상태 확인
+// - 구독 전에 HealthCheck RPC 로 서버 준비 여부를 확인하여 빠르게 실패 (재연결 백오프로 이어짐)
+// - HealthCheck 를 모르는 구버전 서버(Unimplemented)는 정상으로 간주
+// - 프론트에서 GetServerHealth 로 구독자 수/가동 시간 조회
+// - Ping 으로 HealthCheck 왕복 시간(RTT)을 재고, latencyLoop 가 주기적으로 latency 이벤트 발행
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 왕복 지연 측정 주기
+	LATENCY_PROBE_INTERVAL_MS = 5000
+	// 왕복 지연 이벤트 이름
+	EVENT_LATENCY = "latency"
+)
+
+// serverHealth는 프론트로 전달하는 서버 상태 정보입니다.
+type serverHealth struct {
+	Serving             bool  `json:"serving"`
+	OverviewSubscribers int32 `json:"overviewSubscribers"`
+	DetailSubscribers   int32 `json:"detailSubscribers"`
+	EventSubscribers    int32 `json:"eventSubscribers"`
+	UptimeMs            int64 `json:"uptimeMs"`
+}
+
+// checkHealth 구독 전 서버 상태를 확인합니다. NOT_SERVING 이거나 호출 실패 시 에러를 반환합니다.
+func checkHealth(ctx context.Context, client proto.AdminServiceClient) error {
+	ctx, cancel := context.WithTimeout(ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	resp, err := client.HealthCheck(ctx, &proto.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("health check: %w", err)
+	}
+	if resp.GetStatus() != proto.HealthCheckResponse_SERVING {
+		return fmt.Errorf("health check: server %s", resp.GetStatus())
+	}
+	return nil
+}
+
+// GetServerHealth 현재 연결된 서버의 상태를 조회합니다.
+func (a *App) GetServerHealth() (serverHealth, error) {
+	client := a.client()
+	if client == nil {
+		return serverHealth{}, errors.New("not connected")
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	resp, err := client.HealthCheck(ctx, &proto.HealthCheckRequest{})
+	if err != nil {
+		log.Printf("[Admin][RPC] HealthCheck 실패: %v", err)
+		return serverHealth{}, err
+	}
+	return serverHealth{
+		Serving:             resp.GetStatus() == proto.HealthCheckResponse_SERVING,
+		OverviewSubscribers: resp.GetOverviewSubscribers(),
+		DetailSubscribers:   resp.GetDetailSubscribers(),
+		EventSubscribers:    resp.GetEventSubscribers(),
+		UptimeMs:            resp.GetUptimeMs(),
+	}, nil
+}
+
+// Ping 서버까지 왕복 지연(ms)을 측정합니다. 연결 전이면 기다리지 않고 에러를 반환합니다.
+func (a *App) Ping() (int64, error) {
+	client := a.client()
+	if client == nil {
+		return 0, errors.New("not connected")
+	}
+	ctx, cancel := context.WithTimeout(a.ctx, UNARY_RPC_TIMEOUT_MS*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	if _, err := client.HealthCheck(ctx, &proto.HealthCheckRequest{}); err != nil {
+		return 0, err
+	}
+	return time.Since(started).Milliseconds(), nil
+}
+
+// latencyLoop 주기적으로 왕복 지연을 측정하여 프론트로 발행합니다. 연결 전에는 건너뜁니다.
+func (a *App) latencyLoop() {
+	ticker := time.NewTicker(LATENCY_PROBE_INTERVAL_MS * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			if a.client() == nil {
+				continue
+			}
+			rtt, err := a.Ping()
+			payload := map[string]any{"rttMs": rtt}
+			if err != nil {
+				payload["error"] = err.Error()
+			}
+			a.emit(EVENT_LATENCY, payload)
+		}
+	}
+}
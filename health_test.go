@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"admin/internal/server/servertest"
+)
+
+func TestPingMeasuresRoundTrip(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+
+	rtt, err := app.Ping()
+	if err != nil {
+		t.Fatalf("Ping 오류 = %v", err)
+	}
+	if rtt < 0 {
+		t.Fatalf("Ping RTT = %d, want >= 0", rtt)
+	}
+}
+
+func TestPingWithoutConnectionFailsFast(t *testing.T) {
+	app, _ := newTestApp()
+	if _, err := app.Ping(); err == nil {
+		t.Fatal("연결 전 Ping 이 성공함")
+	}
+}
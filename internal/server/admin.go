@@ -5,61 +5,278 @@
 package server
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
 )
 
 const (
-	// 채널 버퍼 크기 (CONTRIBUTING.md 기준)
+	// 기본 채널 버퍼 크기 (CONTRIBUTING.md 기준, WithBufferSize 로 변경 가능)
 	FRAME_CHANNEL_BUFFER_SIZE = 4096
 	// 에이전트 오프라인 상태를 알리기 위한 특수 타임스탬프 값
 	OFFLINE_TIMESTAMP = 0
+	// 에이전트 온라인(복귀) 상태를 알리기 위한 특수 타임스탬프 값 (OFFLINE_TIMESTAMP 와 겹치지 않도록 음수 사용)
+	ONLINE_TIMESTAMP = -1
+	// 느린 소비자 퇴출 기준 연속 드롭 횟수 (0 이하이면 퇴출하지 않음)
+	SLOW_CONSUMER_DROP_THRESHOLD = 100
+	// Agent 별 이벤트 리플레이 버퍼 기본 크기
+	EVENT_REPLAY_BUFFER_SIZE = 50
+	// Admin 1명당 동시 Detail 구독 최대 개수 기본값 (0 이하이면 제한 없음)
+	MAX_DETAIL_SUBSCRIPTIONS_PER_ADMIN = 64
+	// 서버 전체(overview/detail/events 합산) 동시 구독 최대 개수 기본값 (0 이하이면 제한 없음)
+	MAX_TOTAL_SUBSCRIBERS = 1024
+	// Overview 구독 ID 를 전달하는 응답 헤더 키
+	SUBSCRIPTION_ID_METADATA_KEY = "x-subscription-id"
+	// SubscribeEvents 에서 모든 Agent 의 이벤트를 받는 특수 agentId
+	WILDCARD_AGENT_ID = "*"
 )
 
+// overviewKey는 Overview 구독자 맵의 키입니다. 한 Admin 이 여러 Overview 를 구독할 수 있도록 구독 ID 로 구분합니다.
+type overviewKey struct {
+	adminId        string
+	subscriptionId string
+}
+
 // adminSubscriber는 Admin의 구독 정보를 저장합니다.
+//
+// 동시성 불변식: frameChan/eventChan 은 어떤 경로에서도 close 하지 않습니다.
+// broadcast 는 lock 밖에서 전송하므로 구독 종료와 순서가 보장되지 않는데,
+// 채널을 닫지 않으면 "send on closed channel" panic 이 원천적으로 발생할 수 없습니다.
+// 종료는 done(close 전용)으로만 알리고, 모든 전송 select 는 <-done 을 함께 검사해
+// 종료된 구독자에게는 더 이상 적재하지 않습니다. 남은 버퍼는 구독자와 함께 GC 됩니다.
 type adminSubscriber struct {
-	adminId   string
+	adminId string
+	// Detail 프레임 채널 (전체 Detail 은 자동 조정으로 교체될 수 있으므로 frames()/enqueueFrame 으로 접근)
 	frameChan chan *proto.FrameData
+	frameMu   sync.RWMutex
+	resized   chan struct{}
 	eventChan chan *proto.EventData
 	closeOnce sync.Once
 	closeFn   func()
+	// 연속 드롭 횟수 (전송 성공 시 0 으로 초기화)
+	consecutiveDrops atomic.Int64
+	// 누적 드롭 횟수 (Overview 는 병합으로 버려진 프레임 수)
+	totalDrops atomic.Uint64
+	// Detail 전용: frameChan 에 적재된 프레임 바이트 (memory.go 에서 집계)
+	bufferedBytes atomic.Int64
+	// 채널 full 경고 로그 간격 제한 (드롭마다 기록하지 않음)
+	dropLog dropLogLimiter
+	// 느린 소비자 퇴출 신호 (구독 핸들러가 감지 후 스트림 종료)
+	evicted   chan struct{}
+	evictOnce sync.Once
+	// 수신 허용 Agent 집합 (nil 이면 전체 허용, Control 로 교체되므로 atomic - 맵 자체는 불변)
+	agentFilter atomic.Pointer[map[string]struct{}]
+	// 수신 허용 이벤트 타입 집합 (nil 이면 전체 허용) 및 최소 심각도
+	eventTypes  map[string]struct{}
+	minSeverity proto.EventSeverity
+	// Detail 전용: 이 값 이하 타임스탬프의 프레임은 전달하지 않음 (재연결 시 화면 역행 방지)
+	sinceTimestamp int64
+	// Detail 전용: 구독 시 캐시에서 먼저 넣은 프레임 (같은 프레임이 실시간 전달로 다시 오면 생략, 등록 후 불변)
+	catchUp *proto.FrameData
+	// Overview 전용: 개별 프레임 대신 모자이크 프레임만 수신
+	mosaic bool
+	// Overview 전용: 원본 해상도로 받을 포커스 Agent
+	focus overviewFocus
+	// 일시정지 여부 (true 인 동안 broadcast 는 이 구독자를 건너뜀)
+	paused atomic.Bool
+	// 미리보기 구분 필터 (Detail: 미리보기 제외, Overview: 미리보기만)
+	skipPreview  bool
+	previewsOnly bool
+	// Agent 별 전달 속도 제한 (nil 이면 제한 없음, 전체 Detail 기본 적용 / Control 로 변경)
+	rateLimit atomic.Pointer[frameRateLimiter]
+	// Agent 별 N 번째 프레임 샘플링 (nil 이면 전체 전달, Control 로 변경)
+	stride atomic.Pointer[frameStride]
+	// Overview 전용 Agent 별 최신 프레임 병합 큐 (Detail/Events 는 nil)
+	latest *latestFrameQueue
+	// close() 시 닫히는 종료 신호 (채널 자체는 닫지 않음)
+	done chan struct{}
 }
 
-// newAdminSubscriber는 adminSubscriber를 생성합니다.
-func newAdminSubscriber(adminId string) *adminSubscriber {
+// newAdminSubscriber는 bufferSize 크기의 채널을 가진 adminSubscriber를 생성합니다.
+func newAdminSubscriber(adminId string, bufferSize int) *adminSubscriber {
 	return &adminSubscriber{
 		adminId:   adminId,
-		frameChan: make(chan *proto.FrameData, FRAME_CHANNEL_BUFFER_SIZE),
-		eventChan: make(chan *proto.EventData, FRAME_CHANNEL_BUFFER_SIZE),
+		frameChan: make(chan *proto.FrameData, bufferSize),
+		resized:   make(chan struct{}, 1),
+		eventChan: make(chan *proto.EventData, bufferSize),
+		evicted:   make(chan struct{}),
+		done:      make(chan struct{}),
 	}
 }
 
 // newOfflineFrame는 에이전트 오프라인을 표현하는 FrameData를 생성합니다.
-// 이미지 데이터는 비워두고, 타임스탬프를 OFFLINE_TIMESTAMP(=0)으로 설정합니다.
+// Offline 플래그를 설정하고, 구버전 클라이언트 호환을 위해 빈 이미지 + OFFLINE_TIMESTAMP(=0)도 유지합니다.
 func newOfflineFrame(agentId string) *proto.FrameData {
 	return &proto.FrameData{
 		AgentId:   agentId,
 		ImageData: []byte{}, // 빈 데이터로 오프라인 신호
 		Timestamp: OFFLINE_TIMESTAMP,
 		IsPreview: true, // Overview 스트림에서도 식별 가능하도록 preview 표시 유지
+		Offline:   true,
+		Status:    proto.FrameStatus_FRAME_STATUS_OFFLINE,
 	}
 }
 
 // isOfflineFrame는 주어진 프레임이 오프라인 신호인지 판단합니다.
+// Offline 플래그를 우선하며, 플래그가 없는 구버전 신호(OFFLINE_TIMESTAMP + 빈 이미지)도 당분간 인정합니다.
 func isOfflineFrame(frame *proto.FrameData) bool {
 	if frame == nil {
 		return false
 	}
+	if frame.GetOffline() || frame.GetStatus() == proto.FrameStatus_FRAME_STATUS_OFFLINE {
+		return true
+	}
 	return frame.Timestamp == OFFLINE_TIMESTAMP && len(frame.ImageData) == 0
 }
 
-// close 안전하게 구독 채널을 닫습니다.
+// newErrorFrame는 Agent 측 오류(캡처 실패 등)를 표현하는 FrameData를 생성합니다.
+// 오프라인과 구분되도록 FRAME_STATUS_ERROR 와 사유 메시지를 싣고, 타임스탬프는 발생 시각을 사용합니다.
+func newErrorFrame(agentId, message string) *proto.FrameData {
+	return &proto.FrameData{
+		AgentId:       agentId,
+		ImageData:     []byte{},
+		Timestamp:     time.Now().UnixMilli(),
+		IsPreview:     true,
+		Status:        proto.FrameStatus_FRAME_STATUS_ERROR,
+		StatusMessage: message,
+	}
+}
+
+// isErrorFrame는 주어진 프레임이 오류 신호인지 판단합니다.
+func isErrorFrame(frame *proto.FrameData) bool {
+	return frame.GetStatus() == proto.FrameStatus_FRAME_STATUS_ERROR
+}
+
+// newOnlineFrame는 에이전트 온라인(복귀)을 표현하는 FrameData를 생성합니다.
+// 첫 실제 프레임 도착 전에 오프라인 표시를 해제할 수 있도록 ONLINE_TIMESTAMP(=-1)을 사용합니다.
+func newOnlineFrame(agentId string) *proto.FrameData {
+	return &proto.FrameData{
+		AgentId:   agentId,
+		ImageData: []byte{}, // 빈 데이터로 상태 신호
+		Timestamp: ONLINE_TIMESTAMP,
+		IsPreview: true,
+	}
+}
+
+// isOnlineFrame는 주어진 프레임이 온라인 신호인지 판단합니다.
+func isOnlineFrame(frame *proto.FrameData) bool {
+	if frame == nil {
+		return false
+	}
+	return frame.Timestamp == ONLINE_TIMESTAMP && len(frame.ImageData) == 0
+}
+
+// setAgentFilter는 수신 허용 Agent 목록을 설정합니다. 빈 목록이면 전체 허용입니다.
+func (a *adminSubscriber) setAgentFilter(agentIds []string) {
+	if len(agentIds) == 0 {
+		a.agentFilter.Store(nil)
+		return
+	}
+	filter := make(map[string]struct{}, len(agentIds))
+	for _, id := range agentIds {
+		filter[id] = struct{}{}
+	}
+	a.agentFilter.Store(&filter)
+}
+
+// agents는 현재 수신 허용 Agent 집합을 반환합니다. nil 이면 전체 허용이며, 반환한 맵은 수정하지 않습니다.
+func (a *adminSubscriber) agents() map[string]struct{} {
+	if p := a.agentFilter.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// acceptsAgent는 해당 Agent 의 프레임을 수신해야 하는지 판단합니다.
+func (a *adminSubscriber) acceptsAgent(agentId string) bool {
+	filter := a.agents()
+	if filter == nil {
+		return true
+	}
+	_, ok := filter[agentId]
+	return ok
+}
+
+// setEventFilter는 이벤트 타입/최소 심각도 필터를 설정합니다. 빈 타입 목록이면 전체 타입 허용입니다.
+func (a *adminSubscriber) setEventFilter(eventTypes []string, minSeverity proto.EventSeverity) {
+	a.minSeverity = minSeverity
+	if len(eventTypes) == 0 {
+		a.eventTypes = nil
+		return
+	}
+	a.eventTypes = make(map[string]struct{}, len(eventTypes))
+	for _, t := range eventTypes {
+		a.eventTypes[t] = struct{}{}
+	}
+}
+
+// acceptsEvent는 이벤트가 구독 필터를 통과하는지 판단합니다.
+func (a *adminSubscriber) acceptsEvent(event *proto.EventData) bool {
+	if event.GetSeverity() < a.minSeverity {
+		return false
+	}
+	if a.eventTypes == nil {
+		return true
+	}
+	_, ok := a.eventTypes[event.GetEventType()]
+	return ok
+}
+
+// acceptsFrame는 프레임이 미리보기 필터와 sinceTimestamp 조건을 통과하는지 판단합니다.
+// 상태 신호 프레임은 항상 통과합니다.
+func (a *adminSubscriber) acceptsFrame(frame *proto.FrameData) bool {
+	if isSignalFrame(frame) {
+		return true
+	}
+	if a.skipPreview && frame.GetIsPreview() {
+		return false
+	}
+	if a.previewsOnly && !frame.GetIsPreview() {
+		return false
+	}
+	return a.sinceTimestamp <= 0 || frame.GetTimestamp() > a.sinceTimestamp
+}
+
+// recordSent는 전송 성공을 기록하고 연속 드롭 횟수를 초기화합니다.
+func (a *adminSubscriber) recordSent() {
+	a.consecutiveDrops.Store(0)
+}
+
+// recordDrop는 드롭을 기록하고, 연속 드롭이 임계치에 도달하면 퇴출 신호를 보냅니다.
+// broadcast 경로에서는 구독을 직접 정리할 수 없으므로 핸들러가 스스로 종료하도록 신호만 보냅니다.
+// 퇴출이 발생한 경우 true 를 반환합니다.
+func (a *adminSubscriber) recordDrop(threshold int64) bool {
+	a.totalDrops.Add(1)
+	n := a.consecutiveDrops.Add(1)
+	if threshold <= 0 || n < threshold {
+		return false
+	}
+	evicted := false
+	a.evictOnce.Do(func() {
+		close(a.evicted)
+		evicted = true
+	})
+	return evicted
+}
+
+// close 안전하게 구독을 종료합니다.
+// broadcast 는 lock 밖에서 채널에 전송하므로 frameChan/eventChan 은 닫지 않고 done 만 닫습니다.
+// (닫힌 채널 전송 panic 방지, 핸들러는 done 을 보고 종료)
 func (a *adminSubscriber) close() {
 	a.closeOnce.Do(func() {
-		close(a.frameChan)
-		close(a.eventChan)
+		close(a.done)
 		if a.closeFn != nil {
 			a.closeFn()
 		}
@@ -72,151 +289,659 @@ func (a *adminSubscriber) close() {
 type AdminService struct {
 	proto.UnimplementedAdminServiceServer
 	// 구독자 관리용 Mutex 및 맵
-	overviewSubs map[string]*adminSubscriber
+	overviewSubs map[overviewKey]*adminSubscriber
 	detailSubs   map[string]map[string]*adminSubscriber // adminId -> agentId -> sub
-	eventSubs    map[string]map[string]*adminSubscriber
+	detailIndex  subscriberIndex                        // agentId -> subs (broadcast 용 보조 인덱스)
+	eventSubs    map[string]map[string]*adminSubscriber // adminId -> agentId -> sub
+	eventIndex   subscriberIndex                        // agentId -> subs (broadcast 용 보조 인덱스)
 	mu           sync.RWMutex
+	// 느린 소비자 퇴출 기준 연속 드롭 횟수
+	slowConsumerThreshold int64
+	// 구독자 채널 버퍼 크기 및 Agent 수 기반 자동 조정 설정
+	bufferSize int
+	autoTune   bufferAutoTune
+	// 최대 프레임 이미지 크기 (0 이하이면 제한 없음)
+	maxFrameSize int
+	// gzip 지원 클라이언트에 구독 스트림 압축 전송 여부
+	compression bool
+	// Detail 채널 적재 바이트 합계와 soft limit (0 이하이면 제한 없음)
+	bufferedBytes    atomic.Int64
+	maxBufferedBytes int64
+	// 전체 Detail 구독자에게 Agent 별로 전달하는 초당 최대 프레임 수 (0 이하이면 제한 없음)
+	wildcardDetailMaxFPS int
+	// 이 시간 동안 프레임이 없는 Agent 자동 오프라인 처리 (0 이하이면 비활성)
+	agentIdleTimeout time.Duration
+	// 클라이언트 keepalive ping 허용 최소 간격 (0 이하이면 gRPC 기본 정책)
+	keepaliveMinTime time.Duration
+	// Agent 표시 이름/태그 (WithAgentMetadataFile 설정 시 파일에 보관)
+	metadata agentMetadataStore
+	// Agent 별 시청 여부 전환 콜백 (mu 로 보호)
+	watchers watcherState
+	// 구독 감사 기록 (최근 기록 링 버퍼 + 싱크)
+	audits auditLog
+	// 구독 종료 시 남은 버퍼 전송 제한 시간 (0 이하이면 버림)
+	drainTimeout time.Duration
+	// Admin 별 동시 Detail 구독 최대 개수 (0 이하이면 제한 없음)
+	maxDetailPerAdmin int
+	// 서버 전체 동시 구독 최대 개수 및 현재 활성 구독 수 (mu 로 보호)
+	maxSubscribers    int
+	activeSubscribers int
+	// 전송/드롭 통계 카운터 (lock 경합을 피하기 위해 atomic 사용)
+	counters serviceCounters
+	// Shutdown 호출 여부 (mu 로 보호, 이후 신규 구독 거부)
+	shutdown bool
+	// 실행 중인 구독 핸들러 (Shutdown 이 mu 를 놓은 뒤 종료를 기다림)
+	handlers sync.WaitGroup
+	// 서비스 수명 context (Shutdown 시 취소, 이후 프레임 수신/전파는 무시)
+	ctx    context.Context
+	cancel context.CancelFunc
+	// 허용하는 Agent 시계 오차 (0 이하이면 타임스탬프 보정 안 함)
+	maxClockSkew time.Duration
+	// 서비스 생성 시각 (HealthCheck uptime 계산용)
+	startedAt time.Time
+	// 구독 ID 가 없는 Overview 요청에 부여할 일련번호
+	nextSubscriptionId atomic.Uint64
+	// Agent 별 최신 프레임 캐시 (자체 mutex 사용)
+	lastFrames *frameCache
+	// Agent 별 프레임 순번 부여 여부 및 번호 (자체 mutex 사용)
+	sequenceFrames bool
+	sequencer      *frameSequencer
+	// Agent 별 프레임 수신 속도 추정 (자체 mutex 사용)
+	rates *frameRates
+	// Agent 별 최근 이벤트 리플레이 버퍼 크기 및 버퍼
+	eventReplaySize int
+	eventReplay     *eventReplay
+	// Overview 미리보기 재압축 설정 (nil 이면 원본 전달)
+	previewTranscoder *previewTranscoder
+	// 모자이크 합성 주기 (0 이하이면 비활성) 및 격자 설정
+	mosaicInterval time.Duration
+	mosaicLayout   mosaicLayout
+	// 유휴 스트림 heartbeat 간격 (0 이면 비활성)
+	heartbeatInterval time.Duration
+	// 구조화 로거 (adminId/agentId/event/error 를 필드로 기록)
+	logger *slog.Logger
+	// 연속 동일 Overview 프레임 중복 제거 (nil 이면 비활성)
+	deduper *frameDeduper
+	// Agent ID 정규화 함수 (nil 이면 변환 없음, WithAgentIdNormalizer)
+	agentIdNormalizer AgentIdNormalizer
+	// 브로드캐스트 전 적용하는 프레임 필터 체인
+	filtersMu sync.RWMutex
+	filters   []FrameFilter
+	// 감사용 프레임 싱크 (RegisterSink)
+	sinks sinkDispatcher
 }
 
 // NewAdminService는 AdminService를 생성합니다.
-func NewAdminService() *AdminService {
-	return &AdminService{
-		overviewSubs: make(map[string]*adminSubscriber),
-		detailSubs:   make(map[string]map[string]*adminSubscriber),
-		eventSubs:    make(map[string]map[string]*adminSubscriber),
+func NewAdminService(opts ...Option) *AdminService {
+	s := &AdminService{
+		overviewSubs:          make(map[overviewKey]*adminSubscriber),
+		detailSubs:            make(map[string]map[string]*adminSubscriber),
+		detailIndex:           make(subscriberIndex),
+		eventSubs:             make(map[string]map[string]*adminSubscriber),
+		eventIndex:            make(subscriberIndex),
+		slowConsumerThreshold: SLOW_CONSUMER_DROP_THRESHOLD,
+		bufferSize:            FRAME_CHANNEL_BUFFER_SIZE,
+		maxFrameSize:          MAX_FRAME_SIZE_BYTES,
+		maxDetailPerAdmin:     MAX_DETAIL_SUBSCRIPTIONS_PER_ADMIN,
+		maxSubscribers:        MAX_TOTAL_SUBSCRIBERS,
+		maxBufferedBytes:      MAX_BUFFERED_FRAME_BYTES,
+		keepaliveMinTime:      KEEPALIVE_MIN_PING_INTERVAL,
+		wildcardDetailMaxFPS:  WILDCARD_DETAIL_MAX_FPS,
+		mosaicInterval:        MOSAIC_INTERVAL,
+		mosaicLayout:          mosaicLayout{cellWidth: MOSAIC_CELL_WIDTH, cellHeight: MOSAIC_CELL_HEIGHT},
+		logger:                slog.New(slog.NewTextHandler(os.Stderr, nil)),
+		lastFrames:            newFrameCache(),
+		rates:                 newFrameRates(),
+		sequenceFrames:        true,
+		sequencer:             newFrameSequencer(),
+		eventReplaySize:       EVENT_REPLAY_BUFFER_SIZE,
+		maxClockSkew:          FRAME_CLOCK_SKEW_TOLERANCE,
+		startedAt:             time.Now(),
+		audits:                auditLog{size: AUDIT_LOG_SIZE},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.eventReplay = newEventReplay(s.eventReplaySize)
+	if err := s.metadata.load(); err != nil {
+		s.logger.Warn("Agent 메타데이터 로드 실패 - 빈 상태로 시작", "event", "agent_metadata_load_error", "path", s.metadata.path, "error", err)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	if s.agentIdleTimeout > 0 {
+		go s.reapLoop()
+	}
+	if s.mosaicInterval > 0 {
+		go s.mosaicLoop()
+	}
+	return s
+}
+
+// stopped는 Shutdown 이 호출되어 서비스 context 가 취소되었는지 반환합니다.
+func (s *AdminService) stopped() bool {
+	return s.ctx.Err() != nil
+}
+
+// Shutdown은 모든 overview/detail/events 구독자를 닫고 맵을 비운 뒤, 구독 핸들러가 모두 끝날 때까지 기다립니다.
+// 구독자의 done 이 닫히면 각 핸들러의 전송 루프가 정상 종료되어 클라이언트는 EOF 를 받습니다.
+// 서비스 context 를 먼저 취소하므로 이후 도착한 프레임은 캐시/구독자에 반영되지 않습니다.
+// 여러 번 호출해도 안전하며, 이후 들어오는 구독 요청은 거부됩니다.
+// ctx 가 먼저 끝나면 (예: 클라이언트가 읽지 않아 전송이 막힌 핸들러) 기다리기를 멈추고 ctx 오류를 반환합니다.
+//
+// 잠금 순서: mu → (watchers.notifyMu, 구독자 내부 mutex). 핸들러의 정리 defer 도 mu 를 잡으므로
+// Shutdown 은 구독자를 닫는 동안만 mu 를 잡고, 핸들러 종료 대기는 mu 를 놓은 뒤에 합니다.
+// broadcast/스트림 전송도 mu 밖에서 수행하므로 mu 를 잡은 채 막히는 경로가 없습니다.
+func (s *AdminService) Shutdown(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.cancel()
+	s.mu.Lock()
+	if s.shutdown {
+		s.mu.Unlock()
+		return s.waitHandlers(ctx)
+	}
+	s.shutdown = true
+	count := 0
+	for key, sub := range s.overviewSubs {
+		sub.close()
+		delete(s.overviewSubs, key)
+		count++
+	}
+	for adminId, subs := range s.detailSubs {
+		for _, sub := range subs {
+			sub.close()
+			count++
+		}
+		delete(s.detailSubs, adminId)
+	}
+	clear(s.detailIndex)
+	for adminId, subs := range s.eventSubs {
+		for _, sub := range subs {
+			sub.close()
+			count++
+		}
+		delete(s.eventSubs, adminId)
+	}
+	clear(s.eventIndex)
+	s.unlockAndNotifyWatchers()
+	if err := s.waitHandlers(ctx); err != nil {
+		s.logger.Warn("구독 핸들러 종료 대기 중단", "event", "shutdown_timeout", "subscribers", count, "error", err)
+		return err
+	}
+	s.logger.Info("shutdown 완료", "event", "shutdown", "subscribers", count)
+	return nil
+}
+
+// waitHandlers는 실행 중인 구독 핸들러가 모두 끝나거나 ctx 가 끝날 때까지 기다립니다. (mu 를 잡지 않은 상태에서 호출)
+func (s *AdminService) waitHandlers(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.handlers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// admitSubscriberLocked는 서버 전체 구독 한도를 확인하고 활성 구독 수를 1 늘립니다.
+// 반드시 mu 를 쓰기 잠금한 상태에서 호출하며, 성공 시 구독 핸들러의 defer 에서 releaseSubscriberLocked 를 호출해야 합니다.
+// Shutdown 이 기다릴 수 있도록 handlers 에도 등록하므로 핸들러는 종료 시 handlers.Done() 을 호출해야 합니다.
+// (shutdown 확인과 같은 mu 구간에서 Add 하므로 Shutdown 의 Wait 보다 항상 먼저 일어납니다)
+func (s *AdminService) admitSubscriberLocked(kind, adminId string) error {
+	if s.maxSubscribers > 0 && s.activeSubscribers >= s.maxSubscribers {
+		s.logger.Warn("전체 구독 개수 초과", "event", "subscriber_limit_exceeded", "kind", kind, "adminId", adminId, "limit", s.maxSubscribers)
+		return status.Errorf(codes.ResourceExhausted, "server subscriber limit reached (limit %d)", s.maxSubscribers)
+	}
+	s.activeSubscribers++
+	s.handlers.Add(1)
+	return nil
+}
+
+// releaseSubscriberLocked는 활성 구독 수를 1 줄입니다. (mu 쓰기 잠금 상태에서 호출)
+func (s *AdminService) releaseSubscriberLocked() {
+	if s.activeSubscribers > 0 {
+		s.activeSubscribers--
 	}
 }
 
 // SubscribeOverview는 전체 프레임 미리보기를 스트리밍합니다.
 func (s *AdminService) SubscribeOverview(req *proto.AdminSubscribeRequest, stream proto.AdminService_SubscribeOverviewServer) error {
+	return s.serveOverview(req, stream, 0, func(frames []*proto.FrameData) error {
+		for _, frame := range frames {
+			if err := stream.Send(frame); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// serveOverview는 Overview 구독 등록/정리와 전송 루프를 수행합니다.
+// send 는 병합 큐에서 꺼낸 프레임 묶음을 스트림 형식(단건/FrameBatch)에 맞게 전송합니다.
+// batchDelay 가 0 보다 크면 알림 후 그만큼 더 기다려 프레임을 모은 뒤 전송합니다.
+func (s *AdminService) serveOverview(req *proto.AdminSubscribeRequest, stream grpc.ServerStream, batchDelay time.Duration, send func(frames []*proto.FrameData) error) error {
+	if err := validateOverviewRequest(req); err != nil {
+		return err
+	}
+	if req.GetMosaic() && s.mosaicInterval <= 0 {
+		return status.Error(codes.FailedPrecondition, "mosaic overview is disabled on this server")
+	}
 	adminId := req.GetAdminId()
-	sub := newAdminSubscriber(adminId)
+	subscriptionId := req.GetSubscriptionId()
+	if subscriptionId == "" {
+		subscriptionId = fmt.Sprintf("sub-%d", s.nextSubscriptionId.Add(1))
+	}
+	key := overviewKey{adminId: adminId, subscriptionId: subscriptionId}
+	// 서버가 부여한 구독 ID 를 클라이언트가 알 수 있도록 헤더로 전달
+	_ = stream.SetHeader(metadata.Pairs(SUBSCRIPTION_ID_METADATA_KEY, subscriptionId))
+	sub := newAdminSubscriber(adminId, s.overviewBufferSize())
+	sub.latest = newLatestFrameQueue()
+	sub.setAgentFilter(s.normalizeAgentIds(req.GetAgentIds()))
+	sub.previewsOnly = req.GetPreviewsOnly()
+	sub.stride.Store(newFrameStride(req.GetStride()))
+	sub.mosaic = req.GetMosaic()
+	sub.focus.set(s.normalizeAgentId(req.GetFocusAgentId()))
+	sub.paused.Store(req.GetPaused())
 
 	s.mu.Lock()
-	s.overviewSubs[adminId] = sub
-	s.mu.Unlock()
+	if s.shutdown {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "admin service is shutting down")
+	}
+	if err := s.admitSubscriberLocked("overview", adminId); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	// 동일 (adminId, 구독 ID) 의 기존 구독이 있으면 닫고 교체 (이전 스트림은 EOF 로 종료)
+	if prev, ok := s.overviewSubs[key]; ok {
+		s.logger.Info("구독 교체", "event", "subscription_replaced", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId)
+		prev.close()
+	}
+	s.overviewSubs[key] = sub
+	s.unlockAndNotifyWatchers()
+	// 정리 defer 가 모두 끝난 뒤 Shutdown 대기를 해제하도록 가장 먼저 등록
+	defer s.handlers.Done()
+	audit := startAudit("overview", adminId, "", subscriptionId)
 	defer func() {
 		s.mu.Lock()
-		delete(s.overviewSubs, adminId)
-		s.mu.Unlock()
+		// 교체된 이후라면 새 구독자를 지우지 않도록 동일 인스턴스일 때만 삭제
+		if s.overviewSubs[key] == sub {
+			delete(s.overviewSubs, key)
+		}
+		s.releaseSubscriberLocked()
+		s.unlockAndNotifyWatchers()
 		sub.close()
-		log.Printf("[Admin][%s] overview 구독 종료", adminId)
+		s.finishAudit(audit)
+		s.logger.Info("구독 종료", "event", "unsubscribe", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId)
 	}()
 
-	log.Printf("[Admin][%s] overview 구독 시작", adminId)
-	for frame := range sub.frameChan {
-		if err := stream.Send(frame); err != nil {
-			log.Printf("[Admin][%s] overview 전송 오류: %v", adminId, err)
-			return err
+	s.logger.Info("구독 시작", "event", "subscribe", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId)
+	ctx := stream.Context()
+	s.negotiateCompression(ctx)
+	hb := newHeartbeatTimer(s.heartbeatInterval)
+	defer hb.stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// 클라이언트 연결 끊김(취소/리셋) 시 즉시 종료하여 구독 정리
+			s.logger.Info("클라이언트 종료 감지", "event", "client_cancelled", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId, "error", ctx.Err())
+			return ctx.Err()
+		case <-sub.done:
+			// 종료 시점에 병합 큐에 남은 프레임 전송 (drain 설정 시)
+			return drainWithin(s.drainTimeout, func(time.Time) error {
+				return send(sub.latest.drain())
+			})
+		case <-sub.latest.notify:
+			if batchDelay > 0 && !waitBatch(ctx, sub.done, batchDelay) {
+				continue
+			}
+			// 전송이 밀린 동안 쌓인 프레임은 Agent 별 최신 1개로 병합되어 있음
+			if err := send(sub.latest.drain()); err != nil {
+				s.logger.Warn("전송 오류", "event", "send_error", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId, "error", err)
+				return err
+			}
+			hb.reset()
+		case <-hb.C():
+			if err := send([]*proto.FrameData{newHeartbeatFrame("")}); err != nil {
+				s.logger.Warn("heartbeat 전송 오류", "event", "heartbeat_error", "kind", "overview", "adminId", adminId, "subscriptionId", subscriptionId, "error", err)
+				return err
+			}
+			hb.reset()
 		}
 	}
-	return nil
 }
 
 // SubscribeDetail는 특정 Agent의 프레임을 스트리밍합니다.
+// agentId 가 WILDCARD_AGENT_ID("*") 이면 모든 Agent 의 원본 프레임을 Agent 별 속도 제한을 적용해 받습니다.
 func (s *AdminService) SubscribeDetail(req *proto.AgentDetailRequest, stream proto.AdminService_SubscribeDetailServer) error {
+	if err := validateDetailRequest(req); err != nil {
+		return err
+	}
 	adminId := req.GetAdminId()
-	agentId := req.GetAgentId()
-	sub := newAdminSubscriber(adminId)
+	agentId, err := s.detailAgentId(req)
+	if err != nil {
+		return err
+	}
+	// 요청 시에만 미확인 Agent 거부 (Agent 연결 전 미리 구독하는 기존 흐름 유지)
+	if req.GetRequireKnownAgent() && agentId != WILDCARD_AGENT_ID && !s.isKnownAgent(agentId) {
+		return status.Errorf(codes.NotFound, "unknown agent %q", agentId)
+	}
+	bufferSize := s.bufferSize
+	if agentId == WILDCARD_AGENT_ID {
+		bufferSize = s.multiAgentBufferSize()
+	}
+	sub := newAdminSubscriber(adminId, bufferSize)
+	sub.sinceTimestamp = req.GetSinceTimestamp()
+	sub.skipPreview = req.GetSkipPreview()
+	sub.stride.Store(newFrameStride(req.GetStride()))
+	if agentId == WILDCARD_AGENT_ID {
+		sub.rateLimit.Store(newFrameRateLimiter(s.wildcardDetailMaxFPS))
+	}
 
 	s.mu.Lock()
+	if s.shutdown {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "admin service is shutting down")
+	}
 	if s.detailSubs[adminId] == nil {
 		s.detailSubs[adminId] = make(map[string]*adminSubscriber)
 	}
-	s.detailSubs[adminId][agentId] = sub
-	s.mu.Unlock()
-	defer func() {
-		s.mu.Lock()
-		delete(s.detailSubs[adminId], agentId)
+	prev, replacing := s.detailSubs[adminId][agentId]
+	// 동일 Agent 교체는 개수가 늘지 않으므로 제한 대상에서 제외
+	if !replacing && s.maxDetailPerAdmin > 0 && len(s.detailSubs[adminId]) >= s.maxDetailPerAdmin {
+		if len(s.detailSubs[adminId]) == 0 {
+			delete(s.detailSubs, adminId)
+		}
+		s.mu.Unlock()
+		s.logger.Warn("Detail 구독 개수 초과", "event", "detail_limit_exceeded", "kind", "detail", "adminId", adminId, "agentId", agentId, "limit", s.maxDetailPerAdmin)
+		return status.Errorf(codes.ResourceExhausted, "too many detail subscriptions for admin %q (limit %d)", adminId, s.maxDetailPerAdmin)
+	}
+	if err := s.admitSubscriberLocked("detail", adminId); err != nil {
 		if len(s.detailSubs[adminId]) == 0 {
 			delete(s.detailSubs, adminId)
 		}
 		s.mu.Unlock()
+		return err
+	}
+	if replacing {
+		s.logger.Info("구독 교체", "event", "subscription_replaced", "kind", "detail", "adminId", adminId, "agentId", agentId)
+		s.detailIndex.remove(agentId, prev)
+		prev.close()
+	}
+	// 캐시된 최신 프레임을 먼저 넣어 첫 화면을 즉시 표시 (새 채널이므로 블로킹 없음)
+	// since_timestamp 로 재연결한 경우 그보다 새 프레임일 때만 1개 보내 끊긴 동안의 최신 화면을 따라잡음 (at-least-once)
+	if cached, ok := s.lastFrames.load(agentId); ok && sub.acceptsFrame(cached) {
+		sub.frameChan <- cached
+		sub.catchUp = cached
+		s.trackEnqueued(sub, cached)
+	}
+	s.detailSubs[adminId][agentId] = sub
+	s.detailIndex.add(agentId, sub)
+	s.unlockAndNotifyWatchers()
+	// 정리 defer 가 모두 끝난 뒤 Shutdown 대기를 해제하도록 가장 먼저 등록
+	defer s.handlers.Done()
+	audit := startAudit("detail", adminId, agentId, "")
+	defer func() {
+		s.mu.Lock()
+		if s.detailSubs[adminId][agentId] == sub {
+			delete(s.detailSubs[adminId], agentId)
+			if len(s.detailSubs[adminId]) == 0 {
+				delete(s.detailSubs, adminId)
+			}
+		}
+		s.detailIndex.remove(agentId, sub)
+		s.releaseSubscriberLocked()
+		s.unlockAndNotifyWatchers()
 		sub.close()
-		log.Printf("[Admin][%s] detail(%s) 구독 종료", adminId, agentId)
+		s.releaseBuffered(sub)
+		s.finishAudit(audit)
+		s.logger.Info("구독 종료", "event", "unsubscribe", "kind", "detail", "adminId", adminId, "agentId", agentId)
 	}()
 
-	log.Printf("[Admin][%s] detail(%s) 구독 시작", adminId, agentId)
-	for frame := range sub.frameChan {
-		if err := stream.Send(frame); err != nil {
-			log.Printf("[Admin][%s] detail(%s) 전송 오류: %v", adminId, agentId, err)
-			return err
+	s.logger.Info("구독 시작", "event", "subscribe", "kind", "detail", "adminId", adminId, "agentId", agentId)
+	ctx := stream.Context()
+	s.negotiateCompression(ctx)
+	hb := newHeartbeatTimer(s.heartbeatInterval)
+	defer hb.stop()
+	for {
+		frames := sub.frames()
+		select {
+		case <-ctx.Done():
+			s.logger.Info("클라이언트 종료 감지", "event", "client_cancelled", "kind", "detail", "adminId", adminId, "agentId", agentId, "error", ctx.Err())
+			return ctx.Err()
+		case <-sub.evicted:
+			s.logger.Warn("느린 소비자 퇴출", "event", "evicted", "kind", "detail", "adminId", adminId, "agentId", agentId)
+			return status.Error(codes.ResourceExhausted, "slow consumer evicted")
+		case <-sub.done:
+			return drainChannel(s.drainTimeout, sub.frames(), func(frame *proto.FrameData) error {
+				s.trackDequeued(sub, frame)
+				return stream.Send(frame)
+			})
+		case <-sub.resized:
+			// 자동 조정으로 채널이 교체됨 - 다음 반복에서 새 채널을 읽음
+		case frame := <-frames:
+			s.trackDequeued(sub, frame)
+			if err := stream.Send(frame); err != nil {
+				s.logger.Warn("전송 오류", "event", "send_error", "kind", "detail", "adminId", adminId, "agentId", agentId, "error", err)
+				return err
+			}
+			hb.reset()
+		case <-hb.C():
+			if err := stream.Send(newHeartbeatFrame(agentId)); err != nil {
+				s.logger.Warn("heartbeat 전송 오류", "event", "heartbeat_error", "kind", "detail", "adminId", adminId, "agentId", agentId, "error", err)
+				return err
+			}
+			hb.reset()
 		}
 	}
-	return nil
 }
 
 // SubscribeEvents는 특정 Agent의 이벤트를 스트리밍합니다.
+// agentId 가 WILDCARD_AGENT_ID("*") 이면 모든 Agent 의 실시간 이벤트를 받습니다. (리플레이 없음)
 func (s *AdminService) SubscribeEvents(req *proto.AgentDetailRequest, stream proto.AdminService_SubscribeEventsServer) error {
+	if err := validateDetailRequest(req); err != nil {
+		return err
+	}
 	adminId := req.GetAdminId()
-	agentId := req.GetAgentId()
-	sub := newAdminSubscriber(adminId)
+	agentId, err := s.detailAgentId(req)
+	if err != nil {
+		return err
+	}
+	sub := newAdminSubscriber(adminId, s.bufferSize)
+	sub.setEventFilter(req.GetEventTypes(), req.GetMinSeverity())
 
 	s.mu.Lock()
+	if s.shutdown {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "admin service is shutting down")
+	}
+	if err := s.admitSubscriberLocked("events", adminId); err != nil {
+		s.mu.Unlock()
+		return err
+	}
 	if s.eventSubs[adminId] == nil {
 		s.eventSubs[adminId] = make(map[string]*adminSubscriber)
 	}
+	if prev, ok := s.eventSubs[adminId][agentId]; ok {
+		s.logger.Info("구독 교체", "event", "subscription_replaced", "kind", "events", "adminId", adminId, "agentId", agentId)
+		s.eventIndex.remove(agentId, prev)
+		prev.close()
+	}
+	// 최근 이벤트를 순서대로 먼저 전달 (채널 버퍼를 넘는 분량은 블로킹 없이 생략)
+	for _, event := range s.eventReplay.snapshot(agentId) {
+		if !sub.acceptsEvent(event) {
+			continue
+		}
+		select {
+		case sub.eventChan <- event:
+		default:
+		}
+	}
 	s.eventSubs[adminId][agentId] = sub
+	s.eventIndex.add(agentId, sub)
 	s.mu.Unlock()
+	// 정리 defer 가 모두 끝난 뒤 Shutdown 대기를 해제하도록 가장 먼저 등록
+	defer s.handlers.Done()
+	audit := startAudit("events", adminId, agentId, "")
 	defer func() {
 		s.mu.Lock()
-		delete(s.eventSubs[adminId], agentId)
-		if len(s.eventSubs[adminId]) == 0 {
-			delete(s.eventSubs, adminId)
+		if s.eventSubs[adminId][agentId] == sub {
+			delete(s.eventSubs[adminId], agentId)
+			if len(s.eventSubs[adminId]) == 0 {
+				delete(s.eventSubs, adminId)
+			}
 		}
+		s.eventIndex.remove(agentId, sub)
+		s.releaseSubscriberLocked()
 		s.mu.Unlock()
 		sub.close()
-		log.Printf("[Admin][%s] events(%s) 구독 종료", adminId, agentId)
+		s.finishAudit(audit)
+		s.logger.Info("구독 종료", "event", "unsubscribe", "kind", "events", "adminId", adminId, "agentId", agentId)
 	}()
 
-	log.Printf("[Admin][%s] events(%s) 구독 시작", adminId, agentId)
-	for event := range sub.eventChan {
-		if err := stream.Send(event); err != nil {
-			log.Printf("[Admin][%s] events(%s) 전송 오류: %v", adminId, agentId, err)
-			return err
+	s.logger.Info("구독 시작", "event", "subscribe", "kind", "events", "adminId", adminId, "agentId", agentId)
+	ctx := stream.Context()
+	s.negotiateCompression(ctx)
+	hb := newHeartbeatTimer(s.heartbeatInterval)
+	defer hb.stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("클라이언트 종료 감지", "event", "client_cancelled", "kind", "events", "adminId", adminId, "agentId", agentId, "error", ctx.Err())
+			return ctx.Err()
+		case <-sub.evicted:
+			s.logger.Warn("느린 소비자 퇴출", "event", "evicted", "kind", "events", "adminId", adminId, "agentId", agentId)
+			return status.Error(codes.ResourceExhausted, "slow consumer evicted")
+		case <-sub.done:
+			return drainChannel(s.drainTimeout, sub.eventChan, stream.Send)
+		case event := <-sub.eventChan:
+			if err := stream.Send(event); err != nil {
+				s.logger.Warn("전송 오류", "event", "send_error", "kind", "events", "adminId", adminId, "agentId", agentId, "error", err)
+				return err
+			}
+			hb.reset()
+		case <-hb.C():
+			if err := stream.Send(newHeartbeatEvent(agentId)); err != nil {
+				s.logger.Warn("heartbeat 전송 오류", "event", "heartbeat_error", "kind", "events", "adminId", adminId, "agentId", agentId, "error", err)
+				return err
+			}
+			hb.reset()
 		}
 	}
-	return nil
 }
 
 // broadcastOverview는 overview 구독자에게 프레임을 전달합니다.
+// 구독자 목록만 lock 안에서 복사하고, 전달은 lock 밖에서 수행해 느린 구독자가 다른 구독자/생산자를 막지 않게 합니다.
 func (s *AdminService) broadcastOverview(frame *proto.FrameData) {
+	s.broadcastOverviewFocused(frame, frame)
+}
+
+// broadcastOverviewFocused는 미리보기 frame 을 전달하되, 해당 Agent 를 포커스한 구독자에게는 original 을 전달합니다.
+func (s *AdminService) broadcastOverviewFocused(frame, original *proto.FrameData) {
 	s.mu.RLock()
-	defer s.mu.RUnlock()
+	subs := make([]*adminSubscriber, 0, len(s.overviewSubs))
 	for _, sub := range s.overviewSubs {
-		select {
-		case sub.frameChan <- frame:
-		default:
-			log.Printf("[Admin][%s] overview 채널 full", sub.adminId)
+		// 모자이크 구독자는 mosaicLoop 에서 합성 프레임만 받음
+		if !sub.mosaic {
+			subs = append(subs, sub)
+		}
+	}
+	s.mu.RUnlock()
+
+	now := time.Now()
+	for _, sub := range subs {
+		if sub.paused.Load() || !sub.acceptsAgent(frame.GetAgentId()) {
+			continue
+		}
+		out := frame
+		if sub.focus.is(frame.GetAgentId()) {
+			out = original
+		} else if !sub.acceptsFrame(frame) || !sub.allowSample(frame, now) {
+			continue
+		}
+		// 채널 대신 병합 큐 사용: 밀린 이전 프레임은 버리고 최신 프레임만 유지
+		if sub.latest.put(out) {
+			sub.totalDrops.Add(1)
+			s.counters.framesCoalesced.Add(1)
 		}
+		s.counters.framesBroadcast.Add(1)
 	}
 }
 
-// broadcastDetail는 detail 구독자에게 프레임을 전달합니다.
+// broadcastDetail는 해당 Agent 의 detail 구독자와 전체(WILDCARD_AGENT_ID) 구독자에게 프레임을 전달합니다.
 func (s *AdminService) broadcastDetail(agentId string, frame *proto.FrameData) {
 	s.mu.RLock()
-	defer s.mu.RUnlock()
-	for _, sub := range s.detailSubs {
-		if s, ok := sub[agentId]; ok {
-			select {
-			case s.frameChan <- frame:
-			default:
-				log.Printf("[Admin][%s] detail(%s) 채널 full", s.adminId, agentId)
+	subs := s.detailIndex.list(agentId)
+	if agentId != WILDCARD_AGENT_ID {
+		subs = append(subs, s.detailIndex.list(WILDCARD_AGENT_ID)...)
+	}
+	s.mu.RUnlock()
+
+	now := time.Now()
+	for _, sub := range subs {
+		// 캐시 저장 직후 등록된 구독자는 같은 프레임을 이미 캐시에서 받았으므로 중복 전송 생략
+		if sub.paused.Load() || frame == sub.catchUp || !sub.acceptsFrame(frame) || !sub.allowSample(frame, now) {
+			continue
+		}
+		sent, closed := sub.enqueueFrame(frame)
+		switch {
+		case closed:
+			// 전달 도중 종료된 구독자는 건너뜀
+		case sent:
+			s.trackEnqueued(sub, frame)
+			sub.recordSent()
+			s.counters.framesBroadcast.Add(1)
+		default:
+			s.counters.framesDropped.Add(1)
+			s.logChannelFull(sub, "detail", agentId)
+			if sub.recordDrop(s.slowConsumerThreshold) {
+				s.logger.Warn("연속 드롭 임계치 초과 - 퇴출", "event", "evict_threshold", "kind", "detail", "adminId", sub.adminId, "agentId", agentId)
 			}
 		}
 	}
+	s.enforceMemoryLimit()
 }
 
-// broadcastEvents는 events 구독자에게 이벤트를 전달합니다.
+// broadcastEvents는 해당 Agent 구독자와 전체(WILDCARD_AGENT_ID) 구독자에게 이벤트를 전달합니다.
+// 전체 구독자가 출처를 구분할 수 있도록 AgentId 가 비어 있으면 채운 복사본을 전달합니다.
 func (s *AdminService) broadcastEvents(agentId string, event *proto.EventData) {
+	if s.stopped() {
+		return
+	}
+	agentId = s.normalizeAgentId(agentId)
+	// 잘못된 이벤트가 리플레이 버퍼/구독자에 섞이지 않도록 nil 및 대상 Agent 없는 이벤트는 버림
+	if event == nil || agentId == "" || agentId == WILDCARD_AGENT_ID {
+		s.logger.Warn("잘못된 이벤트 무시", "event", "invalid_event", "agentId", agentId, "nil", event == nil)
+		return
+	}
+	if event.GetAgentId() == "" || s.normalizeAgentId(event.GetAgentId()) != event.GetAgentId() {
+		event = gproto.Clone(event).(*proto.EventData)
+		event.AgentId = agentId
+	}
 	s.mu.RLock()
-	defer s.mu.RUnlock()
-	for _, sub := range s.eventSubs {
-		if s, ok := sub[agentId]; ok {
-			select {
-			case s.eventChan <- event:
-			default:
-				log.Printf("[Admin][%s] events(%s) 채널 full", s.adminId, agentId)
+	// RLock 구간 안에서 기록/복사해야 구독 시 리플레이와 실시간 전달 사이에 누락/중복이 없습니다.
+	s.eventReplay.append(agentId, event)
+	subs := append(s.eventIndex.list(agentId), s.eventIndex.list(WILDCARD_AGENT_ID)...)
+	s.mu.RUnlock()
+
+	for _, sub := range subs {
+		if !sub.acceptsEvent(event) {
+			continue
+		}
+		select {
+		case <-sub.done:
+		case sub.eventChan <- event:
+			sub.recordSent()
+			s.counters.eventsBroadcast.Add(1)
+		default:
+			s.counters.eventsDropped.Add(1)
+			s.logChannelFull(sub, "events", agentId)
+			if sub.recordDrop(s.slowConsumerThreshold) {
+				s.logger.Warn("연속 드롭 임계치 초과 - 퇴출", "event", "evict_threshold", "kind", "events", "adminId", sub.adminId, "agentId", agentId)
 			}
 		}
 	}
@@ -226,26 +951,87 @@ func (s *AdminService) broadcastEvents(agentId string, event *proto.EventData) {
 // 해당 에이전트가 오프라인 되었음을 모든 관련 구독자에게 알립니다.
 // Overview 및 Detail 구독자에게 오프라인 프레임을 전송합니다.
 func (s *AdminService) PublishAgentOffline(agentId string) {
+	if s.stopped() {
+		return
+	}
+	agentId = s.normalizeAgentId(agentId)
 	offlineFrame := newOfflineFrame(agentId)
+	s.storeLastFrame(offlineFrame)
 	// Overview 전체 프레임 스트림으로 전송
 	s.broadcastOverview(offlineFrame)
 	// Detail 구독자(해당 agentId)를 대상으로 전송
 	s.broadcastDetail(agentId, offlineFrame)
-	log.Printf("[Agent][%s] offline 프레임 전송 완료", agentId)
+	s.logger.Info("offline 프레임 전송 완료", "event", "agent_offline", "agentId", agentId)
+}
+
+// PublishAgentOnline는 외부(Agent 연결 관리 로직)에서 호출하여
+// 해당 에이전트가 다시 연결되었음을 모든 관련 구독자에게 알립니다.
+// 첫 실제 프레임이 도착하기 전이라도 클라이언트가 오프라인 표시를 해제할 수 있습니다.
+func (s *AdminService) PublishAgentOnline(agentId string) {
+	if s.stopped() {
+		return
+	}
+	agentId = s.normalizeAgentId(agentId)
+	onlineFrame := newOnlineFrame(agentId)
+	s.storeLastFrame(onlineFrame)
+	s.broadcastOverview(onlineFrame)
+	s.broadcastDetail(agentId, onlineFrame)
+	s.logger.Info("online 프레임 전송 완료", "event", "agent_online", "agentId", agentId)
+}
+
+// PublishAgentError는 외부(Agent 연결 관리 로직)에서 호출하여
+// 해당 에이전트에서 오류(캡처 실패 등)가 발생했음을 Overview/Detail 구독자에게 알립니다.
+func (s *AdminService) PublishAgentError(agentId, message string) {
+	if s.stopped() {
+		return
+	}
+	agentId = s.normalizeAgentId(agentId)
+	errorFrame := newErrorFrame(agentId, message)
+	s.storeLastFrame(errorFrame)
+	s.broadcastOverview(errorFrame)
+	s.broadcastDetail(agentId, errorFrame)
+	s.logger.Warn("error 프레임 전송 완료", "event", "agent_error", "agentId", agentId, "message", message)
 }
 
 // HandleIncomingFrame는 외부에서 들어온 프레임을 Admin 구독자에게 배포하는 헬퍼입니다.
-// 오프라인 프레임 여부를 판단하고 그대로 전달합니다.
-// NOTE: 필요 시 추가적인 필터링/캐싱 로직을 여기서 확장할 수 있습니다.
+// AgentId 를 정규화한 뒤 등록된 필터 체인(FrameFilter)을 적용하고, 통과한 프레임을 캐시 후 전달합니다.
+// Shutdown 이후에는 아무것도 하지 않습니다.
 func (s *AdminService) HandleIncomingFrame(frame *proto.FrameData) {
-	if frame == nil {
+	if frame == nil || s.stopped() {
 		return
 	}
-	// Overview 전송 (preview 여부는 클라이언트 로직에 따라 판단)
-	s.broadcastOverview(frame)
-	// Detail (특정 agent) 전송
+	// 필터/크기 검사 로그부터 캐시·라우팅까지 같은 ID 를 보도록 가장 먼저 정규화
+	s.normalizeFrameAgentId(frame)
+	if s.oversized(frame) {
+		return
+	}
+	frame, keep := s.applyFilters(frame)
+	if !keep {
+		return
+	}
+	s.normalizeTimestamp(frame)
+	assignFormat(frame)
+	s.assignSequence(frame)
+	s.recordFrameRate(frame)
+	s.storeLastFrame(frame)
+	s.dispatchToSinks(frame)
+	// Overview 전송 (preview 여부는 클라이언트 로직에 따라 판단, 재압축 설정 시 축소본 전송)
+	// 중복 제거 활성 시 직전과 같은 이미지는 캐시 타임스탬프만 갱신하고 Overview 전송 생략
+	if s.deduper == nil || !s.deduper.isDuplicate(frame) {
+		// 미리보기를 받을 구독자가 없으면(없음/필터 제외/일시정지/포커스) 재압축 생략
+		if s.previewTranscoder != nil && s.overviewWantsPreview(frame.GetAgentId()) {
+			s.broadcastOverviewFocused(s.previewTranscoder.transcode(frame), frame)
+		} else {
+			s.broadcastOverview(frame)
+		}
+	}
+	// Detail (특정 agent) 전송 - 항상 원본
 	s.broadcastDetail(frame.AgentId, frame)
 	if isOfflineFrame(frame) {
-		log.Printf("[Agent][%s] offline 프레임 처리", frame.AgentId)
+		s.logger.Info("offline 프레임 처리", "event", "offline_frame", "agentId", frame.AgentId)
+	} else if isErrorFrame(frame) {
+		s.logger.Warn("error 프레임 처리", "event", "error_frame", "agentId", frame.AgentId, "message", frame.GetStatusMessage())
+	} else if isOnlineFrame(frame) {
+		s.logger.Info("online 프레임 처리", "event", "online_frame", "agentId", frame.AgentId)
 	}
 }
@@ -0,0 +1,403 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// overviewCount는 등록된 Overview 구독자 수를 반환합니다.
+func overviewCount(s *AdminService) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.overviewSubs)
+}
+
+func TestSubscribeOverviewCancelRemovesSubscriber(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 1)
+	errCh := serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, stream)
+	})
+	waitUntil(t, "구독 등록", func() bool { return overviewCount(s) == 1 })
+
+	stream.cancel()
+	if err := waitErr(t, errCh); !errors.Is(err, context.Canceled) {
+		t.Fatalf("SubscribeOverview 반환 오류 = %v, want context.Canceled", err)
+	}
+	waitUntil(t, "구독 정리", func() bool { return overviewCount(s) == 0 })
+}
+
+func TestSubscribeOverviewDuplicateTerminatesPrevious(t *testing.T) {
+	s := newTestService(t)
+	req := &proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: "main"}
+	first := newFakeStream[proto.FrameData](t, 1)
+	firstErr := serve(func() error { return s.SubscribeOverview(req, first) })
+	waitUntil(t, "첫 구독 등록", func() bool { return overviewCount(s) == 1 })
+
+	second := newFakeStream[proto.FrameData](t, 1)
+	secondErr := serve(func() error { return s.SubscribeOverview(req, second) })
+	if err := waitErr(t, firstErr); err != nil {
+		t.Fatalf("교체된 첫 구독 반환 오류 = %v, want nil(EOF)", err)
+	}
+	if got := overviewCount(s); got != 1 {
+		t.Fatalf("교체 후 구독자 수 = %d, want 1", got)
+	}
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("a")})
+	if got := second.next(t).GetAgentId(); got != "agent-1" {
+		t.Fatalf("두 번째 구독 수신 agentId = %q", got)
+	}
+	second.cancel()
+	waitErr(t, secondErr)
+}
+
+// detailSub는 adminId/agentId 로 등록된 Detail 구독자를 반환합니다.
+func detailSub(s *AdminService, adminId, agentId string) *adminSubscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.detailSubs[adminId][agentId]
+}
+
+func TestSlowDetailConsumerEvicted(t *testing.T) {
+	s := newTestService(t, WithBufferSize(1), WithSlowConsumerThreshold(3))
+	// 버퍼 없는 스트림: 핸들러는 첫 전송에서 막히고 이후 프레임은 채널이 차서 드롭됨
+	stream := newFakeStream[proto.FrameData](t, 0)
+	errCh := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	sub := detailSub(s, "admin-1", "agent-1")
+
+	waitUntil(t, "퇴출 신호", func() bool {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("a")})
+		select {
+		case <-sub.evicted:
+			return true
+		default:
+			return false
+		}
+	})
+	// 막힌 전송을 풀어 핸들러가 퇴출을 처리하게 함
+	stream.discard()
+	err := waitErr(t, errCh)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("퇴출된 구독 반환 오류 = %v, want ResourceExhausted", err)
+	}
+	if detailSub(s, "admin-1", "agent-1") != nil {
+		t.Fatal("퇴출된 구독자가 맵에 남아 있음")
+	}
+}
+
+func TestShutdownTerminatesAllSubscribers(t *testing.T) {
+	s := newTestService(t)
+	var errs []<-chan error
+	for _, adminId := range []string{"admin-1", "admin-2", "admin-3"} {
+		overview := newFakeStream[proto.FrameData](t, 1)
+		errs = append(errs, serve(func() error {
+			return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: adminId}, overview)
+		}))
+		detail := newFakeStream[proto.FrameData](t, 1)
+		errs = append(errs, serve(func() error {
+			return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: adminId, AgentId: "agent-1"}, detail)
+		}))
+		events := newFakeStream[proto.EventData](t, 1)
+		errs = append(errs, serve(func() error {
+			return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: adminId, AgentId: "agent-1"}, events)
+		}))
+	}
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().ActiveSubscribers == len(errs) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown 오류 = %v", err)
+	}
+	for _, errCh := range errs {
+		if err := waitErr(t, errCh); err != nil {
+			t.Fatalf("Shutdown 후 구독 반환 오류 = %v, want nil(EOF)", err)
+		}
+	}
+	if st := s.Stats(); st.ActiveSubscribers != 0 || st.OverviewSubscribers != 0 || st.DetailSubscribers != 0 || st.EventSubscribers != 0 {
+		t.Fatalf("Shutdown 후 남은 구독자 = %+v", st)
+	}
+	// 종료 후 새 구독은 거부
+	err := s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-4"}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("Shutdown 후 구독 오류 = %v, want Unavailable", err)
+	}
+}
+
+func TestIngestAfterShutdownIsNoop(t *testing.T) {
+	s := newTestService(t)
+	detail := newFakeStream[proto.FrameData](t, 4)
+	detailErr := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, detail)
+	})
+	events := newFakeStream[proto.EventData](t, 4)
+	eventsErr := serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, events)
+	})
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().ActiveSubscribers == 2 })
+	sub := detailSub(s, "admin-1", "agent-1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown 오류 = %v", err)
+	}
+	waitErr(t, detailErr)
+	waitErr(t, eventsErr)
+
+	// 종료 후 도착한 프레임/이벤트는 panic 없이 무시되고 캐시/구독자에 반영되지 않아야 함
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("late"), Timestamp: time.Now().UnixMilli()})
+	s.PublishAgentOffline("agent-1")
+	s.broadcastEvents("agent-1", &proto.EventData{AgentId: "agent-1"})
+
+	if _, ok := s.lastFrames.load("agent-1"); ok {
+		t.Fatal("Shutdown 후 프레임이 캐시됨")
+	}
+	if n := len(sub.frames()); n != 0 {
+		t.Fatalf("Shutdown 후 닫힌 구독자 채널 적재 = %d", n)
+	}
+	detail.expectNone(t)
+	events.expectNone(t)
+}
+
+func TestSubscribeDetailSendsCachedFrameFirst(t *testing.T) {
+	s := newTestService(t)
+	ts := time.Now().UnixMilli()
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("cached"), Timestamp: ts})
+
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	first := stream.next(t)
+	if string(first.GetImageData()) != "cached" || first.GetTimestamp() != ts {
+		t.Fatalf("첫 프레임 = %q@%d, want 캐시된 프레임", first.GetImageData(), first.GetTimestamp())
+	}
+	stream.expectNone(t)
+}
+
+func TestSubscribeDetailSinceSkipsStaleFrames(t *testing.T) {
+	s := newTestService(t)
+	base := time.Now().UnixMilli()
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("cached"), Timestamp: base})
+
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1", SinceTimestamp: base + 1000}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	// 캐시 프레임이 since 이하이므로 전송하지 않음
+	stream.expectNone(t)
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("old"), Timestamp: base + 1000})
+	stream.expectNone(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("new"), Timestamp: base + 2000})
+	if got := stream.next(t); string(got.GetImageData()) != "new" {
+		t.Fatalf("since 이후 프레임 = %q, want new", got.GetImageData())
+	}
+}
+
+func TestDetailReconnectCatchUpExactlyOnce(t *testing.T) {
+	s := newTestService(t)
+	base := time.Now().UnixMilli()
+	detail := func(since int64) (*fakeStream[proto.FrameData], <-chan error) {
+		stream := newFakeStream[proto.FrameData](t, 4)
+		errCh := serve(func() error {
+			return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1", SinceTimestamp: since}, stream)
+		})
+		waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+		return stream, errCh
+	}
+
+	first, firstErr := detail(0)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("seen"), Timestamp: base})
+	if got := first.next(t); string(got.GetImageData()) != "seen" {
+		t.Fatalf("첫 연결 프레임 = %q, want seen", got.GetImageData())
+	}
+	first.cancel()
+	waitErr(t, firstErr)
+	waitUntil(t, "구독 정리", func() bool { return detailSub(s, "admin-1", "agent-1") == nil })
+
+	// 끊긴 동안 도착한 프레임 중 최신 1 개만 따라잡음
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("missed"), Timestamp: base + 100})
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("latest"), Timestamp: base + 200})
+	second, _ := detail(base)
+	if got := second.next(t); string(got.GetImageData()) != "latest" {
+		t.Fatalf("따라잡기 프레임 = %q, want latest", got.GetImageData())
+	}
+	// 캐시에서 보낸 프레임이 실시간 경로로 다시 와도 중복 전송하지 않음
+	cached, _ := s.lastFrames.load("agent-1")
+	s.broadcastDetail("agent-1", cached)
+	second.expectNone(t)
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("live"), Timestamp: base + 300})
+	if got := second.next(t); string(got.GetImageData()) != "live" {
+		t.Fatalf("재개 후 프레임 = %q, want live", got.GetImageData())
+	}
+	second.expectNone(t)
+}
+
+func TestSubscribeDetailRequireKnownAgent(t *testing.T) {
+	s := newTestService(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "known", ImageData: []byte("img")})
+
+	err := s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "unknown", RequireKnownAgent: true}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("미확인 Agent 구독 오류 = %v, want NotFound", err)
+	}
+
+	stream := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "known", RequireKnownAgent: true}, stream)
+	})
+	if got := stream.next(t).GetAgentId(); got != "known" {
+		t.Fatalf("확인된 Agent 구독 첫 프레임 agentId = %q", got)
+	}
+}
+
+func TestDetailSubscriptionLimitPerAdmin(t *testing.T) {
+	s := newTestService(t, WithMaxDetailSubscriptionsPerAdmin(2))
+	for _, agentId := range []string{"agent-1", "agent-2"} {
+		stream := newFakeStream[proto.FrameData](t, 1)
+		serve(func() error {
+			return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: agentId}, stream)
+		})
+	}
+	waitUntil(t, "한도까지 구독", func() bool { return s.Stats().DetailSubscribers == 2 })
+
+	err := s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-3"}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("한도 초과 구독 오류 = %v, want ResourceExhausted", err)
+	}
+	// 같은 Agent 재구독(교체)과 다른 Admin 은 한도와 무관
+	replace := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, replace)
+	})
+	other := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-2", AgentId: "agent-3"}, other)
+	})
+	waitUntil(t, "교체/다른 Admin 구독", func() bool {
+		return detailSub(s, "admin-2", "agent-3") != nil && s.Stats().DetailSubscribers == 3
+	})
+}
+
+func TestGlobalSubscriberCapAndRecovery(t *testing.T) {
+	s := newTestService(t, WithMaxSubscribers(2))
+	first := newFakeStream[proto.FrameData](t, 1)
+	firstErr := serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, first)
+	})
+	events := newFakeStream[proto.EventData](t, 1)
+	serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-2", AgentId: "agent-1"}, events)
+	})
+	waitUntil(t, "한도까지 구독", func() bool { return s.Stats().ActiveSubscribers == 2 })
+
+	// 종류와 관계없이 전체 한도로 거부
+	if err := s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-3", AgentId: "agent-1"}, newFakeStream[proto.FrameData](t, 1)); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("한도 초과 Detail 구독 오류 = %v, want ResourceExhausted", err)
+	}
+	if err := s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-3"}, newFakeStream[proto.FrameData](t, 1)); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("한도 초과 Overview 구독 오류 = %v, want ResourceExhausted", err)
+	}
+
+	// 하나가 끊기면 다시 수락
+	first.cancel()
+	waitErr(t, firstErr)
+	retry := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-3"}, retry)
+	})
+	waitUntil(t, "해제 후 재구독", func() bool { return overviewSub(s, "admin-3") != nil })
+	if got := s.Stats().ActiveSubscribers; got != 2 {
+		t.Fatalf("ActiveSubscribers = %d, want 2", got)
+	}
+}
+
+func TestMultipleOverviewSubscriptionsPerAdmin(t *testing.T) {
+	s := newTestService(t)
+	var streams []*fakeStream[proto.FrameData]
+	for _, subId := range []string{"grid", "wall"} {
+		stream := newFakeStream[proto.FrameData](t, 1)
+		streams = append(streams, stream)
+		serve(func() error {
+			return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: subId}, stream)
+		})
+	}
+	waitUntil(t, "두 구독 등록", func() bool { return overviewCount(s) == 2 })
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	for i, stream := range streams {
+		if got := stream.next(t).GetAgentId(); got != "agent-1" {
+			t.Fatalf("구독 %d 수신 agentId = %q", i, got)
+		}
+	}
+	if got := streams[0].header.Get(SUBSCRIPTION_ID_METADATA_KEY); len(got) != 1 || got[0] != "grid" {
+		t.Fatalf("구독 ID 헤더 = %v, want [grid]", got)
+	}
+}
+
+func TestDetailSkipPreviewFiltersPreviewFrames(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1", SkipPreview: true}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("preview"), IsPreview: true, Timestamp: time.Now().UnixMilli()})
+	stream.expectNone(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("full"), Timestamp: time.Now().UnixMilli()})
+	if got := stream.next(t); string(got.GetImageData()) != "full" {
+		t.Fatalf("SkipPreview Detail 수신 = %q, want full", got.GetImageData())
+	}
+	// 상태 신호는 IsPreview 여도 항상 전달
+	s.PublishAgentOffline("agent-1")
+	if got := stream.next(t); !isOfflineFrame(got) {
+		t.Fatalf("SkipPreview Detail 상태 신호 = %v, want offline", got)
+	}
+}
+
+func TestOverviewPreviewsOnlyFiltersFullFrames(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", PreviewsOnly: true}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("full"), Timestamp: time.Now().UnixMilli()})
+	stream.expectNone(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("preview"), IsPreview: true, Timestamp: time.Now().UnixMilli()})
+	if got := stream.next(t); string(got.GetImageData()) != "preview" {
+		t.Fatalf("PreviewsOnly Overview 수신 = %q, want preview", got.GetImageData())
+	}
+}
+
+func TestPreviewFilteringDefaultsOff(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	for _, preview := range []bool{true, false} {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), IsPreview: preview, Timestamp: time.Now().UnixMilli()})
+		if got := stream.next(t); got.GetIsPreview() != preview {
+			t.Fatalf("기본 Detail 수신 IsPreview = %v, want %v", got.GetIsPreview(), preview)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// agentid.go: Agent ID 정규화 (옵션)
+// 출처마다 대소문자/공백이 달라("Agent1" vs "agent1 ") 같은 Agent 가 여러 타일로 나뉘는 것을 막기 위해
+// 맵 키로 쓰기 전에 프레임/이벤트/구독 요청의 agentId 를 같은 함수로 정규화합니다. (기본: 변환 없음)
+
+package server
+
+import (
+	"strings"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AgentIdNormalizer는 agentId 를 캐시/구독 키로 쓸 형태로 변환하는 함수입니다.
+type AgentIdNormalizer func(agentId string) string
+
+// TrimLowerAgentId는 앞뒤 공백을 제거하고 소문자로 바꾸는 기본 제공 정규화 함수입니다.
+func TrimLowerAgentId(agentId string) string {
+	return strings.ToLower(strings.TrimSpace(agentId))
+}
+
+// WithAgentIdNormalizer는 Agent ID 정규화 함수를 설정합니다. (예: WithAgentIdNormalizer(TrimLowerAgentId))
+// nil 이면 정규화하지 않습니다.
+func WithAgentIdNormalizer(fn AgentIdNormalizer) Option {
+	return func(s *AdminService) {
+		s.agentIdNormalizer = fn
+	}
+}
+
+// normalizeAgentId는 설정된 함수로 agentId 를 정규화합니다. WILDCARD_AGENT_ID 는 그대로 둡니다.
+func (s *AdminService) normalizeAgentId(agentId string) string {
+	if s.agentIdNormalizer == nil || agentId == WILDCARD_AGENT_ID {
+		return agentId
+	}
+	return s.agentIdNormalizer(agentId)
+}
+
+// normalizeAgentIds는 목록의 각 agentId 를 정규화한 새 목록을 반환합니다.
+func (s *AdminService) normalizeAgentIds(agentIds []string) []string {
+	if s.agentIdNormalizer == nil || len(agentIds) == 0 {
+		return agentIds
+	}
+	out := make([]string, 0, len(agentIds))
+	for _, id := range agentIds {
+		out = append(out, s.normalizeAgentId(id))
+	}
+	return out
+}
+
+// detailAgentId는 Detail/Events 구독 요청의 agentId 를 정규화합니다.
+// 정규화 결과가 비면(공백만 있는 ID 등) validateDetailRequest 와 같이 InvalidArgument 로 거부합니다.
+func (s *AdminService) detailAgentId(req *proto.AgentDetailRequest) (string, error) {
+	agentId := s.normalizeAgentId(req.GetAgentId())
+	if agentId == "" {
+		return "", status.Errorf(codes.InvalidArgument, "agentId is required (adminId %q)", req.GetAdminId())
+	}
+	return agentId, nil
+}
+
+// normalizeFrameAgentId는 프레임의 AgentId 를 정규화합니다. (normalizeTimestamp 와 같이 제자리 수정)
+func (s *AdminService) normalizeFrameAgentId(frame *proto.FrameData) {
+	if s.agentIdNormalizer == nil {
+		return
+	}
+	frame.AgentId = s.normalizeAgentId(frame.GetAgentId())
+}
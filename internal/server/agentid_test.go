@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MIXED_CASE_AGENT_IDS는 같은 Agent 를 출처마다 다르게 표기한 ID 입니다.
+var MIXED_CASE_AGENT_IDS = []string{"Agent1", " agent1 ", "AGENT1"}
+
+func TestMixedCaseAgentIdsCollapse(t *testing.T) {
+	s := newTestService(t, WithAgentIdNormalizer(TrimLowerAgentId))
+	detail := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "Agent1 "}, detail)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent1") != nil })
+	overview := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-2", AgentIds: []string{"AGENT1"}}, overview)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+
+	for _, agentId := range MIXED_CASE_AGENT_IDS {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte(agentId)})
+		if got := detail.next(t).GetAgentId(); got != "agent1" {
+			t.Fatalf("%q 프레임의 Detail 전달 AgentId = %q, want agent1", agentId, got)
+		}
+		if got := overview.next(t).GetAgentId(); got != "agent1" {
+			t.Fatalf("%q 프레임의 Overview 전달 AgentId = %q, want agent1", agentId, got)
+		}
+	}
+
+	agents := s.ListActiveAgents()
+	if len(agents) != 1 || agents[0].AgentId != "agent1" {
+		t.Fatalf("ListActiveAgents = %+v, want agent1 하나", agents)
+	}
+	if got := s.WatcherCount("Agent1"); got != 2 {
+		t.Fatalf("WatcherCount(Agent1) = %d, want 2", got)
+	}
+}
+
+func TestAgentIdsUnchangedByDefault(t *testing.T) {
+	s := newTestService(t)
+	for _, agentId := range MIXED_CASE_AGENT_IDS {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte("img")})
+	}
+	if got := len(s.ListActiveAgents()); got != len(MIXED_CASE_AGENT_IDS) {
+		t.Fatalf("정규화 없는 Agent 수 = %d, want %d", got, len(MIXED_CASE_AGENT_IDS))
+	}
+}
+
+func TestBlankAgentIdRejectedAfterNormalization(t *testing.T) {
+	s := newTestService(t, WithAgentIdNormalizer(TrimLowerAgentId))
+	err := s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "   "}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("공백 agentId 구독 오류 = %v, want InvalidArgument", err)
+	}
+}
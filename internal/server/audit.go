@@ -0,0 +1,96 @@
+// audit.go: 구독 감사 기록 (누가 무엇을 얼마나 구독했는지)
+// 구독이 끝날 때 시작/종료 시각과 구독 시간을 담은 AuditEntry 를 만들어
+// 메모리 링 버퍼(RecentAudits)와 등록된 AuditSink 에 전달합니다.
+// 구독 시작/종료 빈도는 프레임에 비해 매우 낮으므로 싱크는 호출한 고루틴에서 바로 실행합니다.
+
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// 메모리에 보관하는 최근 감사 기록 개수 기본값
+	AUDIT_LOG_SIZE = 1024
+)
+
+// AuditEntry는 구독 1건의 감사 기록입니다.
+type AuditEntry struct {
+	AdminId        string        `json:"adminId"`
+	Kind           string        `json:"kind"` // overview | detail | events
+	AgentId        string        `json:"agentId,omitempty"`
+	SubscriptionId string        `json:"subscriptionId,omitempty"`
+	Start          time.Time     `json:"start"`
+	End            time.Time     `json:"end"`
+	Duration       time.Duration `json:"duration"`
+}
+
+// AuditSink는 완료된 구독 감사 기록을 받는 대상입니다. 구독 핸들러 종료 경로에서 호출되므로 오래 막지 않아야 합니다.
+type AuditSink interface {
+	RecordAudit(entry AuditEntry)
+}
+
+// WithAuditLogSize는 RecentAudits 로 조회할 수 있는 최근 감사 기록 개수를 설정합니다.
+// (기본 AUDIT_LOG_SIZE) 0 이하이면 메모리에 보관하지 않습니다.
+func WithAuditLogSize(n int) Option {
+	return func(s *AdminService) {
+		s.audits.size = n
+	}
+}
+
+// auditLog는 최근 감사 기록 링 버퍼와 등록된 싱크 목록입니다.
+type auditLog struct {
+	mu      sync.Mutex
+	size    int
+	entries []AuditEntry
+	sinks   []AuditSink
+}
+
+// RegisterAuditSink는 감사 기록 싱크를 등록합니다.
+func (s *AdminService) RegisterAuditSink(sink AuditSink) {
+	s.audits.mu.Lock()
+	s.audits.sinks = append(s.audits.sinks, sink)
+	s.audits.mu.Unlock()
+}
+
+// startAudit은 구독 시작 시각을 담은 감사 기록을 만듭니다.
+func startAudit(kind, adminId, agentId, subscriptionId string) AuditEntry {
+	return AuditEntry{
+		AdminId:        adminId,
+		Kind:           kind,
+		AgentId:        agentId,
+		SubscriptionId: subscriptionId,
+		Start:          time.Now(),
+	}
+}
+
+// finishAudit은 종료 시각과 구독 시간을 채워 보관하고 싱크에 전달합니다.
+func (s *AdminService) finishAudit(entry AuditEntry) {
+	entry.End = time.Now()
+	entry.Duration = entry.End.Sub(entry.Start)
+
+	s.audits.mu.Lock()
+	if s.audits.size > 0 {
+		s.audits.entries = append(s.audits.entries, entry)
+		if len(s.audits.entries) > s.audits.size {
+			s.audits.entries = s.audits.entries[len(s.audits.entries)-s.audits.size:]
+		}
+	}
+	sinks := make([]AuditSink, len(s.audits.sinks))
+	copy(sinks, s.audits.sinks)
+	s.audits.mu.Unlock()
+
+	for _, sink := range sinks {
+		sink.RecordAudit(entry)
+	}
+}
+
+// RecentAudits는 보관 중인 최근 감사 기록을 오래된 순서로 반환합니다.
+func (s *AdminService) RecentAudits() []AuditEntry {
+	s.audits.mu.Lock()
+	defer s.audits.mu.Unlock()
+	out := make([]AuditEntry, len(s.audits.entries))
+	copy(out, s.audits.entries)
+	return out
+}
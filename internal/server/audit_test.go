@@ -0,0 +1,71 @@
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// captureAuditSink는 받은 감사 기록을 모으는 테스트용 AuditSink 입니다.
+type captureAuditSink struct {
+	mu      sync.Mutex
+	entries []AuditEntry
+}
+
+func (c *captureAuditSink) RecordAudit(entry AuditEntry) {
+	c.mu.Lock()
+	c.entries = append(c.entries, entry)
+	c.mu.Unlock()
+}
+
+func (c *captureAuditSink) recorded() []AuditEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]AuditEntry(nil), c.entries...)
+}
+
+func TestAuditRecordOnUnsubscribe(t *testing.T) {
+	s := newTestService(t)
+	sink := &captureAuditSink{}
+	s.RegisterAuditSink(sink)
+
+	stream := newFakeStream[proto.FrameData](t, 1)
+	errCh := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	if got := s.RecentAudits(); len(got) != 0 {
+		t.Fatalf("구독 중 감사 기록 = %v, want 종료 후 기록", got)
+	}
+	time.Sleep(10 * time.Millisecond)
+	stream.cancel()
+	waitErr(t, errCh)
+
+	audits := s.RecentAudits()
+	if len(audits) != 1 {
+		t.Fatalf("RecentAudits = %v, want 1 건", audits)
+	}
+	a := audits[0]
+	if a.AdminId != "admin-1" || a.Kind != "detail" || a.AgentId != "agent-1" {
+		t.Fatalf("감사 기록 = %+v", a)
+	}
+	if a.Start.IsZero() || !a.End.After(a.Start) || a.Duration <= 0 || a.Duration != a.End.Sub(a.Start) {
+		t.Fatalf("감사 기록 시각 = start %s end %s duration %s", a.Start, a.End, a.Duration)
+	}
+	if got := sink.recorded(); len(got) != 1 || got[0] != a {
+		t.Fatalf("싱크 기록 = %v, want %v", got, a)
+	}
+}
+
+func TestAuditLogRingSize(t *testing.T) {
+	s := newTestService(t, WithAuditLogSize(2))
+	for _, adminId := range []string{"admin-1", "admin-2", "admin-3"} {
+		s.finishAudit(startAudit("overview", adminId, "", "main"))
+	}
+	audits := s.RecentAudits()
+	if len(audits) != 2 || audits[0].AdminId != "admin-2" || audits[1].AdminId != "admin-3" {
+		t.Fatalf("RecentAudits = %v, want 최근 2 건", audits)
+	}
+}
@@ -0,0 +1,143 @@
+// auth.go: AdminService 토큰 인증 인터셉터
+// 메타데이터 authorization: Bearer <token> 을 검증하고, 요청의 adminId 가 토큰 소유자와 일치하는지 확인합니다.
+// AgentService 등 다른 서비스 호출은 그대로 통과시킵니다.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 인증 대상 서비스 메서드 접두사
+	ADMIN_SERVICE_METHOD_PREFIX = "/monitor.AdminService/"
+	// 인증 없이 허용하는 메서드 (오케스트레이터 probe 용)
+	HEALTH_CHECK_METHOD = ADMIN_SERVICE_METHOD_PREFIX + "HealthCheck"
+	// 토큰 메타데이터 키 및 스킴
+	AUTHORIZATION_METADATA_KEY = "authorization"
+	BEARER_SCHEME              = "bearer "
+)
+
+// TokenValidator는 토큰을 검증하고 토큰 소유 adminId 를 반환합니다.
+// 유효하지 않은 토큰이면 에러를 반환합니다.
+type TokenValidator func(ctx context.Context, token string) (adminId string, err error)
+
+// StaticTokenValidator는 token -> adminId 고정 맵으로 검증하는 TokenValidator 를 생성합니다.
+func StaticTokenValidator(tokens map[string]string) TokenValidator {
+	return func(_ context.Context, token string) (string, error) {
+		adminId, ok := tokens[token]
+		if !ok {
+			return "", status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return adminId, nil
+	}
+}
+
+// adminIdRequest는 adminId 를 포함하는 요청 메시지입니다.
+type adminIdRequest interface {
+	GetAdminId() string
+}
+
+// authAdminIdKey는 인증된 adminId 를 context 에 저장하는 키입니다.
+type authAdminIdKey struct{}
+
+// AuthenticatedAdminId는 인터셉터가 검증한 adminId 를 context 에서 꺼냅니다.
+func AuthenticatedAdminId(ctx context.Context) (string, bool) {
+	adminId, ok := ctx.Value(authAdminIdKey{}).(string)
+	return adminId, ok
+}
+
+// NewAuthInterceptors는 AdminService 호출을 검증하는 unary/stream 인터셉터를 생성합니다.
+// grpc.NewServer(grpc.ChainUnaryInterceptor(u), grpc.ChainStreamInterceptor(st)) 형태로 등록합니다.
+func NewAuthInterceptors(validate TokenValidator) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, ADMIN_SERVICE_METHOD_PREFIX) || info.FullMethod == HEALTH_CHECK_METHOD {
+			return handler(ctx, req)
+		}
+		adminId, err := authenticate(ctx, validate)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkAdminId(adminId, req); err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, authAdminIdKey{}, adminId), req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, ADMIN_SERVICE_METHOD_PREFIX) {
+			return handler(srv, ss)
+		}
+		adminId, err := authenticate(ss.Context(), validate)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authServerStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), authAdminIdKey{}, adminId),
+			adminId:      adminId,
+		})
+	}
+	return unary, stream
+}
+
+// authenticate는 메타데이터의 Bearer 토큰을 검증합니다.
+func authenticate(ctx context.Context, validate TokenValidator) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(AUTHORIZATION_METADATA_KEY)
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	raw := values[0]
+	if len(raw) < len(BEARER_SCHEME) || !strings.EqualFold(raw[:len(BEARER_SCHEME)], BEARER_SCHEME) {
+		return "", status.Error(codes.Unauthenticated, "authorization must use bearer scheme")
+	}
+	adminId, err := validate(ctx, strings.TrimSpace(raw[len(BEARER_SCHEME):]))
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return "", err
+		}
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+	return adminId, nil
+}
+
+// checkAdminId는 요청의 adminId 가 토큰 소유자와 일치하는지 확인합니다 (타 Admin 사칭 방지).
+func checkAdminId(adminId string, req any) error {
+	r, ok := req.(adminIdRequest)
+	if !ok {
+		return nil
+	}
+	if r.GetAdminId() != adminId {
+		return status.Errorf(codes.PermissionDenied, "adminId %q does not match token", r.GetAdminId())
+	}
+	return nil
+}
+
+// authServerStream은 인증 정보를 context 에 싣고, 수신 요청의 adminId 를 검사하는 ServerStream 래퍼입니다.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	adminId string
+}
+
+// Context는 인증된 adminId 가 담긴 context 를 반환합니다.
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg는 요청을 수신한 뒤 adminId 일치 여부를 검사합니다.
+func (s *authServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkAdminId(s.adminId, m)
+}
@@ -0,0 +1,89 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"admin/internal/server"
+	"admin/internal/server/servertest"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// startAuthHarness는 token-1 → admin-1 만 허용하는 인증 인터셉터를 건 하니스를 시작합니다.
+func startAuthHarness(t *testing.T) (*servertest.Harness, proto.AdminServiceClient) {
+	t.Helper()
+	unary, stream := server.NewAuthInterceptors(server.StaticTokenValidator(map[string]string{"token-1": "admin-1"}))
+	return startHarness(t, nil, grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+}
+
+// withToken은 authorization 메타데이터를 실은 context 를 반환합니다.
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, server.AUTHORIZATION_METADATA_KEY, "Bearer "+token)
+}
+
+func TestAuthAcceptsValidToken(t *testing.T) {
+	h, client := startAuthHarness(t)
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+
+	if _, err := client.ListAgents(withToken(ctx, "token-1"), &proto.ListAgentsRequest{AdminId: "admin-1"}); err != nil {
+		t.Fatalf("유효한 토큰 ListAgents 오류 = %v", err)
+	}
+	stream, err := client.SubscribeOverview(withToken(ctx, "token-1"), &proto.AdminSubscribeRequest{AdminId: "admin-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitUntil(t, "인증된 Overview 구독 등록", func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+	h.Service.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("유효한 토큰 구독 수신 오류 = %v", err)
+	}
+}
+
+func TestAuthRejects(t *testing.T) {
+	_, client := startAuthHarness(t)
+	tests := []struct {
+		name    string
+		token   string
+		adminId string
+		want    codes.Code
+	}{
+		{"토큰 없음", "", "admin-1", codes.Unauthenticated},
+		{"잘못된 토큰", "wrong", "admin-1", codes.Unauthenticated},
+		{"다른 adminId 사칭", "token-1", "admin-2", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+			defer cancel()
+			if tt.token != "" {
+				ctx = withToken(ctx, tt.token)
+			}
+			_, err := client.ListAgents(ctx, &proto.ListAgentsRequest{AdminId: tt.adminId})
+			if status.Code(err) != tt.want {
+				t.Fatalf("ListAgents 오류 = %v, want %s", err, tt.want)
+			}
+			stream, err := client.SubscribeOverview(ctx, &proto.AdminSubscribeRequest{AdminId: tt.adminId})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if status.Code(err) != tt.want {
+				t.Fatalf("SubscribeOverview 오류 = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthAllowsHealthCheckWithoutToken(t *testing.T) {
+	_, client := startAuthHarness(t)
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	if _, err := client.HealthCheck(ctx, &proto.HealthCheckRequest{}); err != nil {
+		t.Fatalf("토큰 없는 HealthCheck 오류 = %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+// autotune.go: Agent 수 기반 구독자 버퍼 크기 자동 조정
+// 고정 버퍼(FRAME_CHANNEL_BUFFER_SIZE)는 Agent 수와 무관해 Agent 가 적으면 과하고 많으면 부족하므로,
+// 설정 시 여러 Agent 의 프레임을 한 채널로 받는 구독자는 알려진 Agent 수 × perAgent (최대 maxSize) 크기의 채널을 씁니다.
+// - 전체 Detail("*") 구독: 구독 시점 크기로 frameChan 을 만들고, 새 Agent 가 캐시에 처음 등장하면 새 크기의 채널로 교체
+// - Overview 구독: 같은 크기로 만들고, Agent 수가 바뀌면 전체 Detail 구독과 함께 교체
+// 교체는 구독자의 frameMu 쓰기 잠금 안에서 남은 프레임을 순서대로 옮긴 뒤 resized 로 핸들러에 알립니다.
+// broadcast 는 frameMu 읽기 잠금 안에서 블로킹 없이 적재하므로 교체 중 유실/닫힌 채널 접근이 없습니다.
+// 설정하지 않으면 모든 구독자가 기존 고정 크기를 사용합니다.
+
+package server
+
+import "admin/proto"
+
+const (
+	// 자동 조정 시 최소 채널 크기 (Agent 가 아직 없을 때)
+	AUTOTUNE_MIN_BUFFER_SIZE = 16
+)
+
+// bufferAutoTune은 Agent 당 버퍼 슬롯 수와 상한입니다. (perAgent 0 이하이면 비활성)
+type bufferAutoTune struct {
+	perAgent int
+	maxSize  int
+}
+
+// WithBufferAutoTune은 여러 Agent 를 받는 구독자의 버퍼를 Agent 수 × perAgent 로 자동 조정합니다.
+// maxSize 가 0 이하이면 FRAME_CHANNEL_BUFFER_SIZE 를 상한으로 사용하며, perAgent 가 0 이하이면 비활성(기본)입니다.
+func WithBufferAutoTune(perAgent, maxSize int) Option {
+	return func(s *AdminService) {
+		if maxSize <= 0 {
+			maxSize = FRAME_CHANNEL_BUFFER_SIZE
+		}
+		s.autoTune = bufferAutoTune{perAgent: perAgent, maxSize: maxSize}
+	}
+}
+
+// multiAgentBufferSize는 전체 Detail 구독자의 채널 크기를 반환합니다.
+func (s *AdminService) multiAgentBufferSize() int {
+	if s.autoTune.perAgent <= 0 {
+		return s.bufferSize
+	}
+	size := s.lastFrames.len() * s.autoTune.perAgent
+	return min(max(size, AUTOTUNE_MIN_BUFFER_SIZE), s.autoTune.maxSize)
+}
+
+// overviewBufferSize는 Overview 구독자의 채널 크기를 반환합니다. (모든 Agent 를 받으므로 전체 Detail 과 같은 크기)
+func (s *AdminService) overviewBufferSize() int {
+	return s.multiAgentBufferSize()
+}
+
+// retuneMultiAgentBuffers는 알려진 Agent 수가 바뀐 뒤 전체 Detail/Overview 구독자의 채널을 새 크기로 교체합니다.
+// 새 채널에 다 들어가지 않은 오래된 프레임은 드롭으로 집계합니다.
+func (s *AdminService) retuneMultiAgentBuffers() {
+	if s.autoTune.perAgent <= 0 {
+		return
+	}
+	size := s.multiAgentBufferSize()
+	s.mu.RLock()
+	subs := s.detailIndex.list(WILDCARD_AGENT_ID)
+	for _, sub := range s.overviewSubs {
+		subs = append(subs, sub)
+	}
+	s.mu.RUnlock()
+	for _, sub := range subs {
+		prev, dropped := sub.resizeFrames(size)
+		if prev == size {
+			continue
+		}
+		for _, frame := range dropped {
+			s.trackDequeued(sub, frame)
+			sub.totalDrops.Add(1)
+			s.counters.framesDropped.Add(1)
+		}
+		s.logger.Info("다중 Agent 버퍼 크기 조정", "event", "buffer_retuned", "adminId", sub.adminId, "from", prev, "to", size, "dropped", len(dropped))
+	}
+}
+
+// frames는 현재 frameChan 을 반환합니다. (전체 Detail 구독은 자동 조정으로 교체될 수 있으므로 매번 다시 읽음)
+func (a *adminSubscriber) frames() chan *proto.FrameData {
+	a.frameMu.RLock()
+	defer a.frameMu.RUnlock()
+	return a.frameChan
+}
+
+// enqueueFrame은 frameChan 에 블로킹 없이 프레임을 넣습니다.
+// 종료된 구독자이면 closed, 채널이 가득 차면 sent/closed 모두 false 입니다.
+func (a *adminSubscriber) enqueueFrame(frame *proto.FrameData) (sent, closed bool) {
+	a.frameMu.RLock()
+	defer a.frameMu.RUnlock()
+	select {
+	case <-a.done:
+		return false, true
+	case a.frameChan <- frame:
+		return true, false
+	default:
+		return false, false
+	}
+}
+
+// resizeFrames는 frameChan 을 size 크기의 새 채널로 교체하고 남은 프레임을 순서대로 옮깁니다.
+// 이전 크기와 새 채널에 들어가지 못한 오래된 프레임을 반환하며, 크기가 같으면 교체하지 않습니다.
+func (a *adminSubscriber) resizeFrames(size int) (prev int, dropped []*proto.FrameData) {
+	a.frameMu.Lock()
+	defer a.frameMu.Unlock()
+	prev = cap(a.frameChan)
+	if prev == size {
+		return prev, nil
+	}
+	next := make(chan *proto.FrameData, size)
+	// 핸들러가 동시에 이전 채널에서 꺼낼 수 있으므로 남은 개수만큼 블로킹 없이 옮김
+	for n := len(a.frameChan); n > 0; n-- {
+		select {
+		case frame := <-a.frameChan:
+			if len(next) == cap(next) {
+				dropped = append(dropped, <-next)
+			}
+			next <- frame
+			continue
+		default:
+		}
+		break
+	}
+	a.frameChan = next
+	select {
+	case a.resized <- struct{}{}:
+	default:
+	}
+	return prev, dropped
+}
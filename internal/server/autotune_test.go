@@ -0,0 +1,96 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+// pushAgentRange는 agent-from ~ agent-(to-1) 의 프레임을 하나씩 수신 처리합니다.
+func pushAgentRange(s *AdminService, from, to int) {
+	for i := from; i < to; i++ {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: fmt.Sprintf("agent-%d", i), ImageData: []byte("img")})
+	}
+}
+
+func TestAutoTuneBufferReflectsAgentCount(t *testing.T) {
+	s := newTestService(t, WithBufferAutoTune(4, 100))
+	pushAgentRange(s, 0, 10)
+
+	stream := newFakeStream[proto.FrameData](t, 0)
+	stream.discard()
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: WILDCARD_AGENT_ID}, stream)
+	})
+	waitUntil(t, "전체 Detail 구독 등록", func() bool { return detailSub(s, "admin-1", WILDCARD_AGENT_ID) != nil })
+	sub := detailSub(s, "admin-1", WILDCARD_AGENT_ID)
+	if got := cap(sub.frames()); got != 40 {
+		t.Fatalf("구독 시점 버퍼 크기 = %d, want 10 Agent × 4", got)
+	}
+
+	overviewStream := newFakeStream[proto.FrameData](t, 0)
+	overviewStream.discard()
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-2"}, overviewStream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewSub(s, "admin-2") != nil })
+	overview := overviewSub(s, "admin-2")
+	if got := cap(overview.frames()); got != 40 {
+		t.Fatalf("Overview 구독 시점 버퍼 크기 = %d, want 10 Agent × 4", got)
+	}
+
+	// 새 Agent 가 등장하면 채널을 교체하고, 상한을 넘지 않음
+	pushAgentRange(s, 10, 15)
+	waitUntil(t, "버퍼 확장", func() bool { return cap(sub.frames()) == 60 && cap(overview.frames()) == 60 })
+	pushAgentRange(s, 15, 40)
+	waitUntil(t, "버퍼 상한", func() bool { return cap(sub.frames()) == 100 && cap(overview.frames()) == 100 })
+}
+
+func TestAutoTuneOverviewBufferSize(t *testing.T) {
+	s := newTestService(t, WithBufferAutoTune(2, 50))
+	if got := s.overviewBufferSize(); got != AUTOTUNE_MIN_BUFFER_SIZE {
+		t.Fatalf("Agent 없을 때 Overview 버퍼 크기 = %d, want %d", got, AUTOTUNE_MIN_BUFFER_SIZE)
+	}
+	pushAgentRange(s, 0, 20)
+	if got := s.overviewBufferSize(); got != 40 {
+		t.Fatalf("20 Agent Overview 버퍼 크기 = %d, want 20 × 2", got)
+	}
+	pushAgentRange(s, 20, 30)
+	if got := s.overviewBufferSize(); got != 50 {
+		t.Fatalf("30 Agent Overview 버퍼 크기 = %d, want 상한 50", got)
+	}
+}
+
+func TestAutoTuneDisabledUsesFixedBuffer(t *testing.T) {
+	s := newTestService(t, WithBufferSize(7))
+	pushAgentRange(s, 0, 10)
+	if got := s.multiAgentBufferSize(); got != 7 {
+		t.Fatalf("비활성 전체 Detail 버퍼 크기 = %d, want 7", got)
+	}
+	if got := s.overviewBufferSize(); got != 7 {
+		t.Fatalf("비활성 Overview 버퍼 크기 = %d, want 7", got)
+	}
+}
+
+func TestResizeFramesKeepsNewest(t *testing.T) {
+	sub := newAdminSubscriber("admin-1", 4)
+	for i := range 3 {
+		sub.enqueueFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}})
+	}
+	prev, dropped := sub.resizeFrames(2)
+	if prev != 4 || len(dropped) != 1 || dropped[0].GetImageData()[0] != 0 {
+		t.Fatalf("resizeFrames = %d, %v, want 4 와 가장 오래된 프레임 1 개", prev, dropped)
+	}
+	frames := sub.frames()
+	for _, want := range []byte{1, 2} {
+		if got := (<-frames).GetImageData()[0]; got != want {
+			t.Fatalf("옮긴 프레임 = %d, want %d", got, want)
+		}
+	}
+	select {
+	case <-sub.resized:
+	default:
+		t.Fatal("교체 알림이 없음")
+	}
+}
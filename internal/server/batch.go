@@ -0,0 +1,53 @@
+// batch.go: Overview 프레임 묶음 전송 (SubscribeOverviewBatch)
+// 미리보기가 작고 Agent 가 많으면 프레임마다 Send 하는 비용(HTTP/2 DATA 프레임, syscall)이 커지므로,
+// 병합 큐에서 꺼낸 프레임을 FrameBatch 하나로 묶어 전송합니다.
+// 묶음 크기/대기 시간은 요청의 batch_max_frames / batch_max_delay_ms 로 협상하며,
+// 기존 SubscribeOverview 클라이언트는 영향을 받지 않습니다.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 요청에 묶음 크기가 없을 때 사용하는 FrameBatch 당 최대 프레임 수
+	OVERVIEW_BATCH_MAX_FRAMES = 64
+	// 클라이언트가 요청할 수 있는 최대 묶음 대기 시간 (미리보기 지연 상한)
+	OVERVIEW_BATCH_MAX_DELAY = time.Second
+)
+
+// SubscribeOverviewBatch는 Overview 프레임을 FrameBatch 단위로 스트리밍합니다.
+func (s *AdminService) SubscribeOverviewBatch(req *proto.AdminSubscribeRequest, stream proto.AdminService_SubscribeOverviewBatchServer) error {
+	maxFrames := int(req.GetBatchMaxFrames())
+	if maxFrames <= 0 {
+		maxFrames = OVERVIEW_BATCH_MAX_FRAMES
+	}
+	delay := min(time.Duration(req.GetBatchMaxDelayMs())*time.Millisecond, OVERVIEW_BATCH_MAX_DELAY)
+	return s.serveOverview(req, stream, delay, func(frames []*proto.FrameData) error {
+		for start := 0; start < len(frames); start += maxFrames {
+			end := min(start+maxFrames, len(frames))
+			if err := stream.Send(&proto.FrameBatch{Frames: frames[start:end]}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// waitBatch는 묶음을 모으기 위해 d 만큼 기다립니다. 그 사이 스트림/구독이 끝나면 false 를 반환합니다.
+func waitBatch(ctx context.Context, done <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+// 묶음 전송 테스트/벤치마크에서 한 번에 넣는 Agent(프레임) 수
+const BATCH_TEST_AGENTS = 32
+
+// pushAgents는 서로 다른 Agent 의 프레임을 n 개 넣습니다. (Agent 가 달라 병합되지 않음)
+func pushAgents(s *AdminService, n int) {
+	for i := range n {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: fmt.Sprintf("agent-%d", i), ImageData: []byte{byte(i)}})
+	}
+}
+
+// recvFrames는 Overview 스트림에서 want 개의 프레임을 받을 때까지 읽고 사용한 Send 횟수를 반환합니다.
+func recvFrames(tb testing.TB, s *AdminService, adminId string, want int, batch bool) int {
+	tb.Helper()
+	sends, frames := 0, 0
+	if batch {
+		stream := newFakeStream[proto.FrameBatch](tb, 0)
+		serve(func() error {
+			return s.SubscribeOverviewBatch(&proto.AdminSubscribeRequest{AdminId: adminId, BatchMaxDelayMs: 20}, stream)
+		})
+		waitUntil(tb, "Overview 구독 등록", func() bool { return overviewSub(s, adminId) != nil })
+		pushAgents(s, want)
+		for frames < want {
+			frames += len((<-stream.sent).GetFrames())
+			sends++
+		}
+		stream.cancel()
+		return sends
+	}
+	stream := newFakeStream[proto.FrameData](tb, 0)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: adminId}, stream)
+	})
+	waitUntil(tb, "Overview 구독 등록", func() bool { return overviewSub(s, adminId) != nil })
+	pushAgents(s, want)
+	for frames < want {
+		<-stream.sent
+		frames++
+		sends++
+	}
+	stream.cancel()
+	return sends
+}
+
+func TestOverviewBatchReducesSends(t *testing.T) {
+	s := newTestService(t)
+	sends := recvFrames(t, s, "admin-1", BATCH_TEST_AGENTS, true)
+	if sends >= BATCH_TEST_AGENTS {
+		t.Fatalf("FrameBatch Send 횟수 = %d, want < %d", sends, BATCH_TEST_AGENTS)
+	}
+}
+
+func TestOverviewBatchRespectsMaxFrames(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameBatch](t, 0)
+	serve(func() error {
+		return s.SubscribeOverviewBatch(&proto.AdminSubscribeRequest{AdminId: "admin-1", BatchMaxFrames: 4, BatchMaxDelayMs: 20}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewSub(s, "admin-1") != nil })
+	pushAgents(s, BATCH_TEST_AGENTS)
+	for frames := 0; frames < BATCH_TEST_AGENTS; {
+		batch := stream.next(t)
+		if n := len(batch.GetFrames()); n == 0 || n > 4 {
+			t.Fatalf("FrameBatch 크기 = %d, want 1..4", n)
+		}
+		frames += len(batch.GetFrames())
+	}
+}
+
+// BenchmarkOverviewSends는 같은 프레임 수를 단건/묶음 전송할 때의 Send 횟수를 비교합니다. (sends/op)
+func BenchmarkOverviewSends(b *testing.B) {
+	for _, batch := range []bool{false, true} {
+		name := "single"
+		if batch {
+			name = "batch"
+		}
+		b.Run(name, func(b *testing.B) {
+			s := newTestService(b)
+			sends := 0
+			for i := range b.N {
+				sends += recvFrames(b, s, fmt.Sprintf("admin-%d", i), BATCH_TEST_AGENTS, batch)
+			}
+			b.ReportMetric(float64(sends)/float64(b.N), "sends/op")
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"admin/proto"
+)
+
+// 브로드캐스트 벤치마크 규모: lock 보유 시간이 드러나도록 많은 Overview 구독자
+const BENCH_OVERVIEW_SUBSCRIBERS = 1000
+
+// populateOverview는 핸들러 없이 Overview 구독자 n 개를 등록합니다.
+func populateOverview(s *AdminService, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range n {
+		sub := newAdminSubscriber(fmt.Sprintf("admin-%d", i), 1)
+		sub.latest = newLatestFrameQueue()
+		s.overviewSubs[overviewKey{adminId: sub.adminId}] = sub
+	}
+}
+
+// broadcastOverviewLocked는 lock 밖 전달 도입 전 방식대로 RLock 을 잡은 채 전달합니다. (비교 기준)
+func broadcastOverviewLocked(s *AdminService, frame *proto.FrameData) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, sub := range s.overviewSubs {
+		if !sub.acceptsAgent(frame.GetAgentId()) {
+			continue
+		}
+		if sub.latest.put(frame) {
+			s.counters.framesCoalesced.Add(1)
+		}
+		s.counters.framesBroadcast.Add(1)
+	}
+}
+
+// BenchmarkBroadcastLockedVsSnapshot은 Overview 구독자에게 RLock 을 잡은 채 전달할 때와
+// 목록만 복사한 뒤 lock 밖에서 전달할 때를 비교합니다.
+// 구독/해지처럼 쓰기 잠금을 반복해서 잡는 고루틴을 함께 돌려, 전달 1 회당 쓰기 잠금 획득 수를 writes/op 로 보고합니다.
+func BenchmarkBroadcastLockedVsSnapshot(b *testing.B) {
+	for _, bc := range []struct {
+		name      string
+		broadcast func(*AdminService, *proto.FrameData)
+	}{
+		{"locked", broadcastOverviewLocked},
+		{"snapshot", (*AdminService).broadcastOverview},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			s := newTestService(b)
+			populateOverview(s, BENCH_OVERVIEW_SUBSCRIBERS)
+			frame := &proto.FrameData{AgentId: "agent-0", ImageData: []byte("img")}
+
+			var writes atomic.Int64
+			stop, stopped := make(chan struct{}), make(chan struct{})
+			go func() {
+				defer close(stopped)
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					s.mu.Lock()
+					writes.Add(1)
+					s.mu.Unlock()
+				}
+			}()
+			b.ResetTimer()
+			for range b.N {
+				bc.broadcast(s, frame)
+			}
+			b.StopTimer()
+			close(stop)
+			<-stopped
+			b.ReportMetric(float64(writes.Load())/float64(b.N), "writes/op")
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// cache.go: Agent 별 최신 프레임 캐시
+// Detail 구독 시작 직후 다음 프레임을 기다리지 않고 바로 화면을 그릴 수 있도록 마지막 프레임을 보관합니다.
+// 활성 Agent 목록(ListActiveAgents) 도 이 캐시를 기준으로 만듭니다.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"admin/proto"
+)
+
+// cachedFrame은 캐시된 프레임과 서버 수신 시각, 마지막 ERROR 상태 프레임의 사유입니다.
+type cachedFrame struct {
+	frame  *proto.FrameData
+	seenAt time.Time
+	// 이후 정상 프레임이 와도 유지 (다음 ERROR 프레임이 오면 교체)
+	lastError string
+}
+
+// frameCache는 Agent 별 마지막 프레임을 보관합니다.
+type frameCache struct {
+	mu     sync.RWMutex
+	frames map[string]cachedFrame
+}
+
+// newFrameCache는 frameCache를 생성합니다.
+func newFrameCache() *frameCache {
+	return &frameCache{frames: make(map[string]cachedFrame)}
+}
+
+// store는 Agent 의 마지막 프레임을 갱신합니다. 처음 보는 Agent 이면 true 를 반환합니다.
+func (c *frameCache) store(frame *proto.FrameData) bool {
+	c.mu.Lock()
+	prev, known := c.frames[frame.GetAgentId()]
+	lastError := prev.lastError
+	if isErrorFrame(frame) {
+		lastError = frame.GetStatusMessage()
+		if lastError == "" {
+			lastError = "agent reported error"
+		}
+	}
+	c.frames[frame.GetAgentId()] = cachedFrame{frame: frame, seenAt: time.Now(), lastError: lastError}
+	c.mu.Unlock()
+	return !known
+}
+
+// storeLastFrame은 캐시를 갱신하고, 처음 보는 Agent 이면 Agent 수에 맞춰
+// 다중 Agent 버퍼를 조정하고 기존 구독 기준으로 시청 여부 전환(0→1)을 알립니다.
+func (s *AdminService) storeLastFrame(frame *proto.FrameData) {
+	if s.lastFrames.store(frame) {
+		s.retuneMultiAgentBuffers()
+		s.notifyWatchers()
+	}
+}
+
+// load는 Agent 의 마지막 프레임을 반환합니다. 없으면 false 입니다.
+func (c *frameCache) load(agentId string) (*proto.FrameData, bool) {
+	c.mu.RLock()
+	entry, ok := c.frames[agentId]
+	c.mu.RUnlock()
+	return entry.frame, ok
+}
+
+// len은 캐시된 Agent 수를 반환합니다.
+func (c *frameCache) len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.frames)
+}
+
+// entries는 캐시 전체를 agentId 순으로 복사해 반환합니다.
+func (c *frameCache) entries() []cachedFrame {
+	c.mu.RLock()
+	list := make([]cachedFrame, 0, len(c.frames))
+	for _, entry := range c.frames {
+		list = append(list, entry)
+	}
+	c.mu.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].frame.GetAgentId() < list[j].frame.GetAgentId()
+	})
+	return list
+}
+
+// isKnownAgent는 프레임(상태 신호 포함)을 한 번이라도 수신한 Agent 인지 판단합니다.
+func (s *AdminService) isKnownAgent(agentId string) bool {
+	_, ok := s.lastFrames.load(agentId)
+	return ok
+}
+
+// AgentInfo는 ListActiveAgents 가 반환하는 Agent 상태 요약입니다.
+type AgentInfo struct {
+	AgentId string `json:"agentId"`
+	// 마지막 프레임의 타임스탬프 (Agent 기준, 상태 신호 프레임이면 해당 sentinel 값)
+	LastFrameTimestamp int64 `json:"lastFrameTimestamp"`
+	// 마지막 프레임 수신 시각 (서버 기준)
+	LastSeen time.Time `json:"lastSeen"`
+	// 마지막 프레임이 오프라인 신호였는지 여부 (Online 은 그 반대)
+	Offline bool `json:"offline"`
+	Online  bool `json:"online"`
+	// 마지막 ERROR 상태 프레임의 사유 (이후 정상 프레임이 와도 유지, 없으면 빈 값)
+	LastError string `json:"lastError,omitempty"`
+	// 추정 초당 프레임 수 (AgentRates 와 동일)
+	FPS float64 `json:"fps"`
+	// 운영자가 등록한 표시 이름/태그 (SetAgentMetadata)
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ListActiveAgents는 프레임을 수신한 Agent 목록을 agentId 순으로 반환합니다.
+// Overview 첫 프레임 도착 전에 그리드를 미리 그리는 용도로도 사용할 수 있습니다.
+func (s *AdminService) ListActiveAgents() []AgentInfo {
+	entries := s.lastFrames.entries()
+	rates := s.AgentRates()
+	agents := make([]AgentInfo, 0, len(entries))
+	for _, entry := range entries {
+		agentId := entry.frame.GetAgentId()
+		md, _ := s.GetAgentMetadata(agentId)
+		offline := isOfflineFrame(entry.frame)
+		agents = append(agents, AgentInfo{
+			AgentId:            agentId,
+			LastFrameTimestamp: entry.frame.GetTimestamp(),
+			LastSeen:           entry.seenAt,
+			Offline:            offline,
+			Online:             !offline,
+			LastError:          entry.lastError,
+			FPS:                rates[agentId],
+			Name:               md.Name,
+			Tags:               md.Tags,
+		})
+	}
+	return agents
+}
+
+// ListAgents는 ListActiveAgents 결과를 RPC 응답으로 반환합니다.
+func (s *AdminService) ListAgents(ctx context.Context, req *proto.ListAgentsRequest) (*proto.ListAgentsResponse, error) {
+	agents := s.ListActiveAgents()
+	resp := &proto.ListAgentsResponse{Agents: make([]*proto.AgentStatus, 0, len(agents))}
+	for _, agent := range agents {
+		resp.Agents = append(resp.Agents, &proto.AgentStatus{
+			AgentId:            agent.AgentId,
+			LastFrameTimestamp: agent.LastFrameTimestamp,
+			LastSeen:           agent.LastSeen.UnixMilli(),
+			Offline:            agent.Offline,
+			Name:               agent.Name,
+			Tags:               agent.Tags,
+			Online:             agent.Online,
+			LastError:          agent.LastError,
+			Fps:                agent.FPS,
+		})
+	}
+	return resp, nil
+}
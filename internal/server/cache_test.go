@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestListActiveAgents(t *testing.T) {
+	s := newTestService(t)
+	now := time.Now().UnixMilli()
+	for i, agentId := range []string{"agent-c", "agent-a", "agent-b", "agent-a"} {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte("img"), Timestamp: now + int64(i)})
+	}
+
+	agents := s.ListActiveAgents()
+	ids := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		ids = append(ids, agent.AgentId)
+	}
+	if want := []string{"agent-a", "agent-b", "agent-c"}; !slices.Equal(ids, want) {
+		t.Fatalf("ListActiveAgents = %v, want %v", ids, want)
+	}
+	// 같은 Agent 는 마지막 프레임 기준
+	if agents[0].LastFrameTimestamp != now+3 || agents[0].LastSeen.IsZero() || !agents[0].Online {
+		t.Fatalf("agent-a 정보 = %+v", agents[0])
+	}
+}
+
+func TestListActiveAgentsEmpty(t *testing.T) {
+	s := newTestService(t)
+	if agents := s.ListActiveAgents(); len(agents) != 0 {
+		t.Fatalf("프레임 없는 서비스 ListActiveAgents = %v", agents)
+	}
+}
+
+func TestListAgentsHandler(t *testing.T) {
+	s := newTestService(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-b", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	s.PublishAgentOffline("agent-a")
+
+	resp, err := s.ListAgents(context.Background(), &proto.ListAgentsRequest{AdminId: "admin-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	agents := resp.GetAgents()
+	if len(agents) != 2 || agents[0].GetAgentId() != "agent-a" || agents[1].GetAgentId() != "agent-b" {
+		t.Fatalf("ListAgents = %v, want [agent-a agent-b]", agents)
+	}
+	if !agents[0].GetOffline() || agents[0].GetOnline() {
+		t.Fatalf("agent-a 상태 = %v, want offline", agents[0])
+	}
+	if agents[1].GetOffline() || !agents[1].GetOnline() || agents[1].GetLastSeen() == 0 {
+		t.Fatalf("agent-b 상태 = %v, want online", agents[1])
+	}
+}
+
+func TestAgentInfoFieldsFromMixedFrames(t *testing.T) {
+	s := newTestService(t)
+	if err := s.SetAgentMetadata("agent-live", AgentMetadata{Name: "Lobby"}); err != nil {
+		t.Fatal(err)
+	}
+	frame := func(agentId string) *proto.FrameData {
+		return &proto.FrameData{AgentId: agentId, ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()}
+	}
+	for range 5 {
+		s.HandleIncomingFrame(frame("agent-live"))
+		time.Sleep(20 * time.Millisecond)
+	}
+	s.HandleIncomingFrame(frame("agent-err"))
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-err", Status: proto.FrameStatus_FRAME_STATUS_ERROR, StatusMessage: "capture failed", Timestamp: time.Now().UnixMilli()})
+	// 오류 이후 정상 프레임이 와도 마지막 오류 사유는 유지
+	s.HandleIncomingFrame(frame("agent-err"))
+	s.HandleIncomingFrame(frame("agent-off"))
+	s.PublishAgentOffline("agent-off")
+
+	byId := make(map[string]AgentInfo)
+	for _, agent := range s.ListActiveAgents() {
+		byId[agent.AgentId] = agent
+	}
+	live := byId["agent-live"]
+	if !live.Online || live.Offline || live.Name != "Lobby" || live.LastError != "" || live.FPS <= 0 || live.LastSeen.IsZero() || live.LastFrameTimestamp == 0 {
+		t.Fatalf("agent-live 정보 = %+v", live)
+	}
+	if errAgent := byId["agent-err"]; !errAgent.Online || errAgent.LastError != "capture failed" {
+		t.Fatalf("agent-err 정보 = %+v, want online + capture failed", errAgent)
+	}
+	if off := byId["agent-off"]; off.Online || !off.Offline || off.LastSeen.IsZero() {
+		t.Fatalf("agent-off 정보 = %+v, want offline", off)
+	}
+
+	// 클라이언트로 그대로 보낼 수 있는 JSON 값 타입
+	data, err := json.Marshal(live)
+	if err != nil {
+		t.Fatalf("AgentInfo JSON: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"agentId", "name", "lastSeen", "online", "fps"} {
+		if _, ok := decoded[key]; !ok {
+			t.Fatalf("AgentInfo JSON 에 %q 없음: %s", key, data)
+		}
+	}
+}
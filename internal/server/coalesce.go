@@ -0,0 +1,67 @@
+// coalesce.go: Overview 프레임 최신값 병합(newest-wins) 큐
+// Overview 그리드는 Agent 별 가장 최신 미리보기만 의미가 있으므로,
+// 전송이 밀리는 동안 들어온 프레임은 Agent 단위로 덮어써 메모리를 Agent 수 만큼으로 제한합니다.
+
+package server
+
+import (
+	"sync"
+
+	"admin/proto"
+)
+
+// latestFrameQueue는 Agent 별 최신 프레임 슬롯과 알림 채널로 구성된 병합 큐입니다.
+type latestFrameQueue struct {
+	mu      sync.Mutex
+	pending map[string]*proto.FrameData
+	order   []string // 대기 중인 Agent 의 최초 도착 순서
+	notify  chan struct{}
+}
+
+// newLatestFrameQueue는 latestFrameQueue를 생성합니다.
+func newLatestFrameQueue() *latestFrameQueue {
+	return &latestFrameQueue{
+		pending: make(map[string]*proto.FrameData),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// put은 Agent 의 대기 프레임을 최신 프레임으로 교체합니다.
+// 아직 전송되지 않은 이전 프레임을 덮어쓴 경우 true 를 반환합니다.
+func (q *latestFrameQueue) put(frame *proto.FrameData) bool {
+	agentId := frame.GetAgentId()
+	q.mu.Lock()
+	_, replaced := q.pending[agentId]
+	if !replaced {
+		q.order = append(q.order, agentId)
+	}
+	q.pending[agentId] = frame
+	q.mu.Unlock()
+
+	// 알림은 최대 1개만 대기 (이미 대기 중이면 생략)
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return replaced
+}
+
+// depth는 전송 대기 중인 프레임 수를 반환합니다.
+func (q *latestFrameQueue) depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.order)
+}
+
+// drain은 대기 중인 프레임을 도착 순서대로 모두 꺼냅니다.
+func (q *latestFrameQueue) drain() []*proto.FrameData {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	frames := make([]*proto.FrameData, 0, len(q.order))
+	for _, agentId := range q.order {
+		frames = append(frames, q.pending[agentId])
+		delete(q.pending, agentId)
+	}
+	q.order = q.order[:0]
+	return frames
+}
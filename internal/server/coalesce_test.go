@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestLatestFrameQueueKeepsNewestPerAgent(t *testing.T) {
+	q := newLatestFrameQueue()
+	if q.put(&proto.FrameData{AgentId: "a", ImageData: []byte("a1")}) {
+		t.Fatal("첫 프레임이 교체로 집계됨")
+	}
+	q.put(&proto.FrameData{AgentId: "b", ImageData: []byte("b1")})
+	if !q.put(&proto.FrameData{AgentId: "a", ImageData: []byte("a2")}) {
+		t.Fatal("대기 중 프레임 교체가 집계되지 않음")
+	}
+	frames := q.drain()
+	if len(frames) != 2 || string(frames[0].GetImageData()) != "a2" || string(frames[1].GetImageData()) != "b1" {
+		t.Fatalf("drain = %v, want [a2 b1]", frames)
+	}
+	if q.depth() != 0 {
+		t.Fatalf("drain 후 depth = %d", q.depth())
+	}
+}
+
+// overviewSub는 adminId 의 Overview 구독자를 하나 반환합니다. (없으면 nil)
+func overviewSub(s *AdminService, adminId string) *adminSubscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for key, sub := range s.overviewSubs {
+		if key.adminId == adminId {
+			return sub
+		}
+	}
+	return nil
+}
+
+func TestOverviewBurstDeliversNewestPerAgent(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 0)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, stream)
+	})
+	waitUntil(t, "구독 등록", func() bool { return overviewSub(s, "admin-1") != nil })
+	sub := overviewSub(s, "admin-1")
+
+	// 첫 프레임을 꺼낸 핸들러가 전송에서 막혀 있는 동안 몰아서 보냄
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "a", ImageData: []byte("a0")})
+	waitUntil(t, "첫 프레임 전송 대기", func() bool { return sub.latest.depth() == 0 })
+	for i := 1; i <= 5; i++ {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "a", ImageData: []byte(fmt.Sprintf("a%d", i))})
+	}
+	for i := 1; i <= 2; i++ {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "b", ImageData: []byte(fmt.Sprintf("b%d", i))})
+	}
+
+	for _, want := range []string{"a0", "a5", "b2"} {
+		if got := string(stream.next(t).GetImageData()); got != want {
+			t.Fatalf("수신 프레임 = %q, want %q", got, want)
+		}
+	}
+	stream.expectNone(t)
+	if got := s.Stats().FramesCoalesced; got != 5 {
+		t.Fatalf("FramesCoalesced = %d, want 5", got)
+	}
+}
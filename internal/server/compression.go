@@ -0,0 +1,36 @@
+// compression.go: gRPC gzip 압축 협상
+// gzip 압축기를 등록해 두어 클라이언트가 압축해 보낸 요청은 항상 해제할 수 있고,
+// WithCompression(true) 이면 gzip 을 지원한다고 알린 클라이언트의 구독 스트림을 gzip 으로 보냅니다. (기본 비활성)
+// JPEG/PNG/WebP 처럼 이미 압축된 이미지는 크기가 거의 줄지 않고 CPU 만 쓰므로,
+// 무압축(raw) 프레임을 보내는 Agent 가 있거나 링크가 매우 느린 환경에서만 켭니다.
+
+package server
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// WithCompression은 gzip 을 지원하는 클라이언트에게 구독 스트림을 gzip 으로 보낼지 설정합니다.
+func WithCompression(enabled bool) Option {
+	return func(s *AdminService) {
+		s.compression = enabled
+	}
+}
+
+// negotiateCompression은 압축이 켜져 있고 클라이언트가 gzip 을 지원하면 스트림 응답 압축기를 gzip 으로 지정합니다.
+func (s *AdminService) negotiateCompression(ctx context.Context) {
+	if !s.compression {
+		return
+	}
+	supported, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil || !slices.Contains(supported, gzip.Name) {
+		return
+	}
+	if err := grpc.SetSendCompressor(ctx, gzip.Name); err != nil {
+		s.logger.Warn("압축 설정 실패", "event", "compression_error", "error", err)
+	}
+}
@@ -0,0 +1,110 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"admin/internal/server"
+	"admin/internal/server/servertest"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// 압축 테스트 프레임 크기 (무압축 raw 프레임처럼 압축이 잘 되는 데이터)
+const COMPRESSION_TEST_FRAME_SIZE = 256 << 10
+
+// countingConn은 클라이언트가 읽은 바이트 수를 셉니다.
+type countingConn struct {
+	net.Conn
+	read *atomic.Int64
+}
+
+func (c countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.read.Add(int64(n))
+	return n, err
+}
+
+// dialCounting은 받은 바이트 수를 세는 연결로 하니스에 접속합니다. gzipCalls 이면 모든 호출에 gzip 을 요청합니다.
+func dialCounting(t *testing.T, h *servertest.Harness, gzipCalls bool) (proto.AdminServiceClient, *atomic.Int64) {
+	t.Helper()
+	read := &atomic.Int64{}
+	dial := h.Dialer()
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			conn, err := dial(ctx, addr)
+			if err != nil {
+				return nil, err
+			}
+			return countingConn{Conn: conn, read: read}, nil
+		}),
+	}
+	if gzipCalls {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
+	conn, err := grpc.NewClient(servertest.BUFCONN_ADDRESS, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return proto.NewAdminServiceClient(conn), read
+}
+
+// detailRoundTrip은 Detail 구독으로 image 프레임을 받아 내용과 받은 바이트 수를 반환합니다.
+func detailRoundTrip(t *testing.T, serverCompression, clientGzip bool, image []byte) ([]byte, int64) {
+	t.Helper()
+	h := servertest.Start(server.NewAdminService(quietLogger(), server.WithCompression(serverCompression)))
+	t.Cleanup(h.Close)
+	client, read := dialCounting(t, h, clientGzip)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	stream, err := client.SubscribeDetail(ctx, &proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitUntil(t, "Detail 구독 등록", func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	before := read.Load()
+	h.Service.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: image, Timestamp: time.Now().UnixMilli()})
+	frame, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv 오류 = %v", err)
+	}
+	return frame.GetImageData(), read.Load() - before
+}
+
+func TestCompressionRoundTrips(t *testing.T) {
+	image := bytes.Repeat([]byte("raw-pixels"), COMPRESSION_TEST_FRAME_SIZE/10)
+	cases := []struct {
+		name                          string
+		serverCompression, clientGzip bool
+		compressed                    bool
+	}{
+		{"off", false, false, false},
+		// gzip 이 등록된 클라이언트는 grpc-accept-encoding 으로 지원을 알리므로 서버 설정만으로 압축
+		{"server only", true, false, true},
+		// gRPC 서버는 요청과 같은 압축기로 응답
+		{"client only", false, true, true},
+		{"both", true, true, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, wire := detailRoundTrip(t, tc.serverCompression, tc.clientGzip, image)
+			if !bytes.Equal(got, image) {
+				t.Fatalf("수신 이미지 %d bytes 가 원본과 다름", len(got))
+			}
+			// 압축된 경우에만 전송량이 원본보다 크게 줄어듦
+			if compressed := wire < int64(len(image))/2; compressed != tc.compressed {
+				t.Fatalf("수신 바이트 = %d (원본 %d), want compressed=%v", wire, len(image), tc.compressed)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// control.go: 구독 제어 스트림 (Control RPC)
+// 구독 파라미터(Agent 허용 목록/FPS/stride/포커스/일시정지)를 바꾸려고 스트림을 다시 여는 대신,
+// 클라이언트가 Control 양방향 스트림으로 변경 요청을 보내면 실행 중인 adminSubscriber 상태에 mu 아래에서 반영합니다.
+// - 대상: detail_agent_id 가 있으면 (admin_id, detail_agent_id) Detail 구독, 없으면 (admin_id, subscription_id) Overview 구독
+// - 요청마다 같은 request_id 의 ControlResponse 를 1개 보냅니다. 적용 실패(대상 없음, 잘못된 요청)는 ok=false 로 알리고 스트림은 유지합니다.
+// - 변경은 다음 broadcast 부터 적용되며, 이미 병합 큐/채널에 들어간 프레임은 그대로 전달됩니다.
+// - Agent 허용 목록 변경과 일시정지/재개는 시청자 수(WatcherCount) 전환 콜백에도 반영됩니다.
+
+package server
+
+import (
+	"errors"
+	"io"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Control은 구독 제어 요청을 받아 적용하고 요청마다 결과를 응답합니다.
+func (s *AdminService) Control(stream proto.AdminService_ControlServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp := &proto.ControlResponse{RequestId: req.GetRequestId(), Ok: true}
+		if err := s.applyControl(req); err != nil {
+			resp.Ok = false
+			st := status.Convert(err)
+			resp.Error = st.Message()
+			resp.Code = uint32(st.Code())
+			s.logger.Warn("구독 제어 실패", "event", "control_rejected", "adminId", req.GetAdminId(), "subscriptionId", req.GetSubscriptionId(), "agentId", req.GetDetailAgentId(), "error", err)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// applyControl은 제어 요청 1개를 대상 구독자에 적용합니다.
+func (s *AdminService) applyControl(req *proto.ControlRequest) error {
+	adminId := req.GetAdminId()
+	if adminId == "" {
+		return status.Error(codes.InvalidArgument, "adminId is required")
+	}
+	detailAgentId := s.normalizeAgentId(req.GetDetailAgentId())
+	overview := detailAgentId == ""
+	if overview && req.GetSubscriptionId() == "" {
+		return status.Error(codes.InvalidArgument, "subscriptionId or detailAgentId is required")
+	}
+
+	s.mu.Lock()
+	var sub *adminSubscriber
+	resumed := false
+	if overview {
+		sub = s.overviewSubs[overviewKey{adminId: adminId, subscriptionId: req.GetSubscriptionId()}]
+	} else {
+		sub = s.detailSubs[adminId][detailAgentId]
+	}
+	if sub == nil {
+		s.mu.Unlock()
+		return status.Error(codes.NotFound, "subscription not found")
+	}
+	switch u := req.GetUpdate().(type) {
+	case *proto.ControlRequest_Agents:
+		if !overview {
+			s.mu.Unlock()
+			return status.Error(codes.InvalidArgument, "agent allowlist applies to overview subscriptions only")
+		}
+		sub.setAgentFilter(s.normalizeAgentIds(u.Agents.GetAgentIds()))
+	case *proto.ControlRequest_MaxFps:
+		fps := int(u.MaxFps)
+		if detailAgentId == WILDCARD_AGENT_ID {
+			var err error
+			if fps, err = s.wildcardDetailFPS(fps); err != nil {
+				s.mu.Unlock()
+				return err
+			}
+		}
+		sub.rateLimit.Store(newFrameRateLimiter(fps))
+	case *proto.ControlRequest_Stride:
+		sub.stride.Store(newFrameStride(u.Stride))
+	case *proto.ControlRequest_FocusAgentId:
+		if !overview {
+			s.mu.Unlock()
+			return status.Error(codes.InvalidArgument, "focus applies to overview subscriptions only")
+		}
+		sub.focus.set(s.normalizeAgentId(u.FocusAgentId))
+	case *proto.ControlRequest_Paused:
+		resumed = sub.setPaused(u.Paused)
+	default:
+		s.mu.Unlock()
+		return status.Error(codes.InvalidArgument, "update is required")
+	}
+	s.unlockAndNotifyWatchers()
+	if resumed {
+		s.resume(sub, detailAgentId)
+	}
+	s.logger.Info("구독 제어 적용", "event", "control_applied", "adminId", adminId, "subscriptionId", req.GetSubscriptionId(), "agentId", detailAgentId)
+	return nil
+}
@@ -0,0 +1,119 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// recvAgentFrame은 heartbeat 를 건너뛰고 다음 Agent 프레임을 받습니다.
+func recvAgentFrame(t *testing.T, stream grpc.ServerStreamingClient[proto.FrameData]) *proto.FrameData {
+	t.Helper()
+	for {
+		frame, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Overview 수신: %v", err)
+		}
+		if frame.GetAgentId() != "" {
+			return frame
+		}
+	}
+}
+
+// sendControl은 제어 요청을 보내고 같은 request_id 의 응답을 받습니다.
+func sendControl(t *testing.T, ctl grpc.BidiStreamingClient[proto.ControlRequest, proto.ControlResponse], req *proto.ControlRequest) *proto.ControlResponse {
+	t.Helper()
+	if err := ctl.Send(req); err != nil {
+		t.Fatalf("Control 전송: %v", err)
+	}
+	resp, err := ctl.Recv()
+	if err != nil {
+		t.Fatalf("Control 응답: %v", err)
+	}
+	if resp.GetRequestId() != req.GetRequestId() {
+		t.Fatalf("Control 응답 request_id = %d, want %d", resp.GetRequestId(), req.GetRequestId())
+	}
+	return resp
+}
+
+func TestControlChangesAllowlistMidStream(t *testing.T) {
+	h, client := startHarness(t, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+
+	stream, err := client.SubscribeOverview(ctx, &proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: "main", AgentIds: []string{"agent-1"}})
+	if err != nil {
+		t.Fatalf("SubscribeOverview: %v", err)
+	}
+	waitUntil(t, "Overview 구독 등록", func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+	// push 는 Agent 순서대로 프레임을 넣고 처음 전달된 Agent 를 반환합니다. (허용되지 않은 Agent 가 먼저 들어가므로 걸러졌는지 확인 가능)
+	push := func(agentIds ...string) string {
+		for _, agentId := range agentIds {
+			h.Service.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte(agentId), Timestamp: time.Now().UnixMilli()})
+		}
+		return recvAgentFrame(t, stream).GetAgentId()
+	}
+	if got := push("agent-2", "agent-1"); got != "agent-1" {
+		t.Fatalf("변경 전 첫 프레임 = %q, want agent-1", got)
+	}
+
+	ctl, err := client.Control(ctx)
+	if err != nil {
+		t.Fatalf("Control: %v", err)
+	}
+	resp := sendControl(t, ctl, &proto.ControlRequest{AdminId: "admin-1", SubscriptionId: "main", RequestId: 1,
+		Update: &proto.ControlRequest_Agents{Agents: &proto.AgentAllowlist{AgentIds: []string{"agent-2"}}}})
+	if !resp.GetOk() {
+		t.Fatalf("허용 목록 변경 실패: %s", resp.GetError())
+	}
+	if got := push("agent-1", "agent-2"); got != "agent-2" {
+		t.Fatalf("변경 후 첫 프레임 = %q, want agent-2", got)
+	}
+
+	// 빈 목록은 전체 허용
+	resp = sendControl(t, ctl, &proto.ControlRequest{AdminId: "admin-1", SubscriptionId: "main", RequestId: 2,
+		Update: &proto.ControlRequest_Agents{Agents: &proto.AgentAllowlist{}}})
+	if !resp.GetOk() {
+		t.Fatalf("허용 목록 해제 실패: %s", resp.GetError())
+	}
+	if got := push("agent-3"); got != "agent-3" {
+		t.Fatalf("해제 후 프레임 = %q, want agent-3", got)
+	}
+}
+
+func TestControlRejectsWithoutClosingStream(t *testing.T) {
+	h, client := startHarness(t, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	ctl, err := client.Control(ctx)
+	if err != nil {
+		t.Fatalf("Control: %v", err)
+	}
+
+	resp := sendControl(t, ctl, &proto.ControlRequest{AdminId: "admin-1", SubscriptionId: "missing", RequestId: 1,
+		Update: &proto.ControlRequest_MaxFps{MaxFps: 5}})
+	if resp.GetOk() || codes.Code(resp.GetCode()) != codes.NotFound {
+		t.Fatalf("없는 구독 제어 응답 = %+v, want NotFound", resp)
+	}
+
+	// 전체 Detail 구독은 서버 상한 때문에 제한 해제(0)를 거부
+	if _, err := client.SubscribeDetail(ctx, &proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "*"}); err != nil {
+		t.Fatalf("SubscribeDetail: %v", err)
+	}
+	waitUntil(t, "Detail 구독 등록", func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	resp = sendControl(t, ctl, &proto.ControlRequest{AdminId: "admin-1", DetailAgentId: "*", RequestId: 2,
+		Update: &proto.ControlRequest_MaxFps{MaxFps: 0}})
+	if resp.GetOk() || codes.Code(resp.GetCode()) != codes.InvalidArgument {
+		t.Fatalf("전체 Detail 제한 해제 응답 = %+v, want InvalidArgument", resp)
+	}
+	resp = sendControl(t, ctl, &proto.ControlRequest{AdminId: "admin-1", DetailAgentId: "*", RequestId: 3,
+		Update: &proto.ControlRequest_MaxFps{MaxFps: 100}})
+	if !resp.GetOk() {
+		t.Fatalf("전체 Detail fps 변경 실패: %s", resp.GetError())
+	}
+}
@@ -0,0 +1,48 @@
+// dedup.go: 연속 동일 Overview 프레임 중복 제거 (옵션)
+// 화면이 정지된 Agent 는 같은 이미지를 반복 전송하므로, 직전에 전달한 이미지와 해시가 같으면
+// Overview 전송을 생략하고 캐시의 타임스탬프만 갱신합니다. Detail 구독자는 계속 모든 프레임을 받습니다.
+
+package server
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"admin/proto"
+)
+
+// WithFrameDedup은 연속 동일 Overview 프레임 중복 제거를 활성화합니다. (기본 비활성)
+func WithFrameDedup() Option {
+	return func(s *AdminService) {
+		s.deduper = newFrameDeduper()
+	}
+}
+
+// frameDeduper는 Agent 별 직전 전달 이미지 해시를 보관합니다.
+type frameDeduper struct {
+	mu   sync.Mutex
+	last map[string][sha256.Size]byte
+}
+
+// newFrameDeduper는 frameDeduper를 생성합니다.
+func newFrameDeduper() *frameDeduper {
+	return &frameDeduper{last: make(map[string][sha256.Size]byte)}
+}
+
+// isDuplicate는 직전 전달 이미지와 동일한지 판단하고, 다르면 해시를 갱신합니다.
+// 상태 신호(빈 이미지) 프레임은 항상 false 이며 비교 기준을 초기화합니다.
+func (d *frameDeduper) isDuplicate(frame *proto.FrameData) bool {
+	agentId := frame.GetAgentId()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(frame.GetImageData()) == 0 {
+		delete(d.last, agentId)
+		return false
+	}
+	sum := sha256.Sum256(frame.GetImageData())
+	if prev, ok := d.last[agentId]; ok && prev == sum {
+		return true
+	}
+	d.last[agentId] = sum
+	return false
+}
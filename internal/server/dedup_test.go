@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestFrameDedupSkipsIdenticalOverviewFrames(t *testing.T) {
+	s := newTestService(t, WithFrameDedup())
+	overview := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, overview)
+	})
+	detail := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, detail)
+	})
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().ActiveSubscribers == 2 })
+
+	// 전송 여부를 프레임마다 확인해 병합(coalesce)과 구분
+	for _, step := range []struct {
+		image     string
+		broadcast bool
+	}{
+		{"a", true},
+		{"a", false},
+		{"b", true},
+		{"b", false},
+		{"a", true},
+	} {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte(step.image)})
+		if step.broadcast {
+			if got := string(overview.next(t).GetImageData()); got != step.image {
+				t.Fatalf("Overview 수신 = %q, want %q", got, step.image)
+			}
+		} else {
+			overview.expectNone(t)
+		}
+		// Detail 구독자는 중복 여부와 관계없이 모두 받음
+		if got := string(detail.next(t).GetImageData()); got != step.image {
+			t.Fatalf("Detail 수신 = %q, want %q", got, step.image)
+		}
+	}
+}
+
+func TestFrameDedupResetsOnSignal(t *testing.T) {
+	d := newFrameDeduper()
+	frame := &proto.FrameData{AgentId: "agent-1", ImageData: []byte("a")}
+	if d.isDuplicate(frame) || !d.isDuplicate(frame) {
+		t.Fatal("동일 이미지 판별 실패")
+	}
+	// 오프라인 등 상태 신호 후 같은 이미지는 다시 전달
+	if d.isDuplicate(newOfflineFrame("agent-1")) || d.isDuplicate(frame) {
+		t.Fatal("상태 신호 후 비교 기준이 초기화되지 않음")
+	}
+}
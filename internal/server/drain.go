@@ -0,0 +1,60 @@
+// drain.go: 구독 종료 시 남은 버퍼 전송 (drain-on-close)
+// close() 는 done 만 닫으므로 채널 버퍼에 남은 프레임/이벤트는 기본적으로 버려집니다.
+// 녹화/감사용 클라이언트가 마지막 구간을 잃지 않도록, 설정 시 종료 시점에 버퍼에 있던 분량만
+// 제한 시간 안에서 전송한 뒤 스트림을 끝냅니다. (종료 이후 broadcast 는 done 을 보고 더 적재하지 않음)
+
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithDrainOnClose는 구독 종료 시 버퍼에 남은 항목을 timeout 동안 전송하도록 설정합니다.
+// 0 이하이면 남은 항목을 버리고 즉시 종료합니다. (기본값)
+func WithDrainOnClose(timeout time.Duration) Option {
+	return func(s *AdminService) {
+		s.drainTimeout = timeout
+	}
+}
+
+// drainWithin은 drain 을 별도 고루틴에서 실행하고, timeout 이 지나면 완료를 기다리지 않고 반환합니다.
+// stream.Send 가 흐름 제어로 막혀도 핸들러(와 이를 기다리는 Shutdown)가 timeout 을 넘겨 붙잡히지 않습니다.
+// 남은 고루틴은 핸들러 반환 후 스트림 컨텍스트가 취소되어 Send 가 실패하면 끝납니다.
+func drainWithin(timeout time.Duration, drain func(deadline time.Time) error) error {
+	if timeout <= 0 {
+		return nil
+	}
+	result := make(chan error, 1)
+	go func() {
+		result <- drain(time.Now().Add(timeout))
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		return status.Error(codes.DeadlineExceeded, "drain timeout")
+	}
+}
+
+// drainChannel은 종료 시점에 ch 버퍼에 있던 항목만 꺼내 전송합니다.
+// 제한 시간을 넘기거나 전송이 실패하면 중단합니다.
+func drainChannel[T any](timeout time.Duration, ch <-chan T, send func(T) error) error {
+	return drainWithin(timeout, func(deadline time.Time) error {
+		for n := len(ch); n > 0 && time.Now().Before(deadline); n-- {
+			select {
+			case item := <-ch:
+				if err := send(item); err != nil {
+					return err
+				}
+			default:
+				return nil
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrainOnCloseDeliversBufferedFrames(t *testing.T) {
+	s := newTestService(t, WithBufferSize(8), WithSlowConsumerThreshold(0), WithDrainOnClose(TEST_WAIT_TIMEOUT))
+	stream := newFakeStream[proto.FrameData](t, 0)
+	errCh := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	sub := detailSub(s, "admin-1", "agent-1")
+
+	// 첫 프레임은 핸들러가 Send 에서 붙잡고, 나머지는 버퍼에 남음
+	const frames = 4
+	for i := range frames {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte(fmt.Sprintf("frame-%d", i)), Timestamp: time.Now().UnixMilli()})
+	}
+	waitUntil(t, "버퍼 적재", func() bool { return len(sub.frames()) == frames-1 })
+
+	shutdownErr := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+		defer cancel()
+		shutdownErr <- s.Shutdown(ctx)
+	}()
+	waitUntil(t, "구독 종료 신호", func() bool {
+		select {
+		case <-sub.done:
+			return true
+		default:
+			return false
+		}
+	})
+
+	// 종료 후에도 버퍼에 있던 프레임이 순서대로 모두 전송된 뒤 EOF
+	for i := range frames {
+		if got, want := string(stream.next(t).GetImageData()), fmt.Sprintf("frame-%d", i); got != want {
+			t.Fatalf("drain 전송 %d = %q, want %q", i, got, want)
+		}
+	}
+	if err := waitErr(t, errCh); err != nil {
+		t.Fatalf("drain 후 구독 반환 오류 = %v, want nil(EOF)", err)
+	}
+	if err := <-shutdownErr; err != nil {
+		t.Fatalf("Shutdown 오류 = %v", err)
+	}
+}
+
+func TestDrainChannelBoundedByTimeout(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	stalled := make(chan struct{})
+	defer close(stalled)
+
+	// 전송이 막혀도 drain 은 timeout 에서 반환되어야 함
+	const timeout = 50 * time.Millisecond
+	start := time.Now()
+	err := drainChannel(timeout, ch, func(int) error {
+		<-stalled
+		return nil
+	})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("막힌 drain 오류 = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed >= TEST_WAIT_TIMEOUT {
+		t.Fatalf("막힌 drain 반환까지 %v, want timeout(%v) 근처", elapsed, timeout)
+	}
+}
+
+func TestDrainWithinBoundsOverviewSend(t *testing.T) {
+	stalled := make(chan struct{})
+	defer close(stalled)
+
+	err := drainWithin(50*time.Millisecond, func(time.Time) error {
+		<-stalled
+		return nil
+	})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("막힌 Overview drain 오류 = %v, want DeadlineExceeded", err)
+	}
+	if err := drainWithin(0, func(time.Time) error { t.Fatal("drain 비활성 시 전송 호출됨"); return nil }); err != nil {
+		t.Fatalf("drain 비활성 오류 = %v, want nil", err)
+	}
+}
@@ -0,0 +1,44 @@
+// droplog.go: 드롭 경고 로그 샘플링
+// 다수 구독자가 동시에 가득 차면 드롭마다 "채널 full" 을 남겨 초당 수천 줄이 쌓이므로,
+// 구독자별로 DROP_LOG_INTERVAL 당 최대 1회만 기록하고 그 사이 드롭 수를 합산해 함께 남깁니다.
+
+package server
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// 구독자별 드롭 경고 로그 최소 간격
+	DROP_LOG_INTERVAL = time.Second
+)
+
+// dropLogLimiter는 구독자 하나의 드롭 로그 마지막 기록 시각과 미기록 드롭 수입니다.
+type dropLogLimiter struct {
+	lastLogged atomic.Int64 // UnixNano
+	pending    atomic.Uint64
+}
+
+// allow는 드롭 1건을 누적하고, 로그를 남길 차례이면 마지막 기록 이후 누적된 드롭 수와 true 를 반환합니다.
+// 여러 broadcast 가 동시에 호출해도 간격당 한 호출만 true 를 받습니다.
+func (l *dropLogLimiter) allow(now time.Time) (uint64, bool) {
+	l.pending.Add(1)
+	last := l.lastLogged.Load()
+	if last != 0 && now.UnixNano()-last < int64(DROP_LOG_INTERVAL) {
+		return 0, false
+	}
+	if !l.lastLogged.CompareAndSwap(last, now.UnixNano()) {
+		return 0, false
+	}
+	return l.pending.Swap(0), true
+}
+
+// logChannelFull은 구독자별 간격 제한을 적용해 채널 full 경고를 기록합니다.
+func (s *AdminService) logChannelFull(sub *adminSubscriber, kind, agentId string) {
+	dropped, ok := sub.dropLog.allow(time.Now())
+	if !ok {
+		return
+	}
+	s.logger.Warn("채널 full", "event", "channel_full", "kind", kind, "adminId", sub.adminId, "agentId", agentId, "dropped", dropped)
+}
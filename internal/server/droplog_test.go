@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestDropLogBoundedDuringBurst(t *testing.T) {
+	logger, logs := newCaptureLogger()
+	s := newTestService(t, WithLogger(logger), WithBufferSize(1), WithSlowConsumerThreshold(0))
+	startStalledDetail(t, s, "admin-1", "agent-1")
+
+	const burst = 500
+	for range burst {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("frame")})
+	}
+	if dropped := s.Stats().FramesDropped; dropped < burst-1 {
+		t.Fatalf("FramesDropped = %d, want >= %d", dropped, burst-1)
+	}
+	// DROP_LOG_INTERVAL 안의 드롭은 구독자당 1줄
+	if got := len(logs.withEvent("channel_full")); got != 1 {
+		t.Fatalf("channel_full 로그 = %d 줄, want 1", got)
+	}
+}
+
+func TestDropLogLimiterAggregatesCount(t *testing.T) {
+	var l dropLogLimiter
+	now := time.Now()
+	if n, ok := l.allow(now); !ok || n != 1 {
+		t.Fatalf("첫 드롭 = (%d, %v), want (1, true)", n, ok)
+	}
+	for i := range 9 {
+		if _, ok := l.allow(now.Add(time.Duration(i+1) * time.Millisecond)); ok {
+			t.Fatal("간격 안의 드롭이 기록됨")
+		}
+	}
+	// 간격이 지나면 그 사이 누적분을 합산해 한 번 기록
+	if n, ok := l.allow(now.Add(DROP_LOG_INTERVAL)); !ok || n != 10 {
+		t.Fatalf("간격 후 드롭 = (%d, %v), want (10, true)", n, ok)
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestSubscribeEventsFiltersByTypeAndSeverity(t *testing.T) {
+	s := newTestService(t, WithEventReplaySize(0))
+	typed := newFakeStream[proto.EventData](t, 8)
+	serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1", EventTypes: []string{"usb", "printer"}}, typed)
+	})
+	severe := newFakeStream[proto.EventData](t, 8)
+	serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-2", AgentId: "agent-1", MinSeverity: proto.EventSeverity_EVENT_SEVERITY_WARNING}, severe)
+	})
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().EventSubscribers == 2 })
+
+	for _, event := range []*proto.EventData{
+		{EventType: "keyboard", EventDetail: "k", Severity: proto.EventSeverity_EVENT_SEVERITY_INFO},
+		{EventType: "usb", EventDetail: "u", Severity: proto.EventSeverity_EVENT_SEVERITY_INFO},
+		{EventType: "mouse", EventDetail: "m", Severity: proto.EventSeverity_EVENT_SEVERITY_ERROR},
+		{EventType: "printer", EventDetail: "p", Severity: proto.EventSeverity_EVENT_SEVERITY_WARNING},
+	} {
+		event.AgentId = "agent-1"
+		s.broadcastEvents("agent-1", event)
+	}
+
+	for _, want := range []string{"u", "p"} {
+		if got := typed.next(t).GetEventDetail(); got != want {
+			t.Fatalf("타입 필터 구독 수신 = %q, want %q", got, want)
+		}
+	}
+	typed.expectNone(t)
+	for _, want := range []string{"m", "p"} {
+		if got := severe.next(t).GetEventDetail(); got != want {
+			t.Fatalf("심각도 필터 구독 수신 = %q, want %q", got, want)
+		}
+	}
+	severe.expectNone(t)
+}
+
+// eventSub은 adminId/agentId 의 Events 구독을 시작하고 스트림과 종료 채널을 반환합니다.
+func eventSub(t *testing.T, s *AdminService, adminId, agentId string) (*fakeStream[proto.EventData], <-chan error) {
+	t.Helper()
+	stream := newFakeStream[proto.EventData](t, 8)
+	errCh := serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: adminId, AgentId: agentId}, stream)
+	})
+	waitUntil(t, "Events 구독 등록", func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		_, ok := s.eventSubs[adminId][agentId]
+		return ok
+	})
+	return stream, errCh
+}
+
+func TestEventsRoutedAfterChurn(t *testing.T) {
+	s := newTestService(t, WithEventReplaySize(0))
+	a1, a1Err := eventSub(t, s, "admin-1", "agent-1")
+	a2, a2Err := eventSub(t, s, "admin-2", "agent-1")
+	a3, _ := eventSub(t, s, "admin-3", "agent-2")
+
+	// admin-2 는 agent-2 로 옮기고, admin-1 은 같은 Agent 를 다시 구독
+	a2.cancel()
+	waitErr(t, a2Err)
+	a2, _ = eventSub(t, s, "admin-2", "agent-2")
+	a1.cancel()
+	waitErr(t, a1Err)
+	a1, _ = eventSub(t, s, "admin-1", "agent-1")
+
+	s.broadcastEvents("agent-1", &proto.EventData{AgentId: "agent-1", EventDetail: "e1"})
+	s.broadcastEvents("agent-2", &proto.EventData{AgentId: "agent-2", EventDetail: "e2"})
+
+	if got := a1.next(t).GetEventDetail(); got != "e1" {
+		t.Fatalf("admin-1 수신 = %q, want e1", got)
+	}
+	for name, stream := range map[string]*fakeStream[proto.EventData]{"admin-2": a2, "admin-3": a3} {
+		if got := stream.next(t).GetEventDetail(); got != "e2" {
+			t.Fatalf("%s 수신 = %q, want e2", name, got)
+		}
+	}
+	a1.expectNone(t)
+	a2.expectNone(t)
+	a3.expectNone(t)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if got := len(s.eventIndex["agent-1"]); got != 1 {
+		t.Fatalf("agent-1 이벤트 인덱스 = %d, want 1", got)
+	}
+	if got := len(s.eventIndex["agent-2"]); got != 2 {
+		t.Fatalf("agent-2 이벤트 인덱스 = %d, want 2", got)
+	}
+}
+
+func TestWildcardEventsFromAllAgents(t *testing.T) {
+	s := newTestService(t, WithEventReplaySize(0))
+	all, allErr := eventSub(t, s, "admin-1", WILDCARD_AGENT_ID)
+	one, _ := eventSub(t, s, "admin-2", "agent-1")
+
+	for _, agentId := range []string{"agent-1", "agent-2", "agent-3"} {
+		s.broadcastEvents(agentId, &proto.EventData{AgentId: agentId, EventType: "usb"})
+	}
+	for _, want := range []string{"agent-1", "agent-2", "agent-3"} {
+		if got := all.next(t).GetAgentId(); got != want {
+			t.Fatalf("전체 구독 수신 AgentId = %q, want %q", got, want)
+		}
+	}
+	if got := one.next(t).GetAgentId(); got != "agent-1" {
+		t.Fatalf("개별 구독 수신 AgentId = %q, want agent-1", got)
+	}
+	one.expectNone(t)
+
+	// 전체 구독 종료 시 인덱스에서도 정리
+	all.cancel()
+	waitErr(t, allErr)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.eventIndex[WILDCARD_AGENT_ID]; ok {
+		t.Fatal("종료한 전체 구독이 이벤트 인덱스에 남음")
+	}
+	if _, ok := s.eventSubs["admin-1"]; ok {
+		t.Fatal("종료한 전체 구독이 eventSubs 에 남음")
+	}
+}
+
+func TestMalformedEventsDropped(t *testing.T) {
+	logger, logs := newCaptureLogger()
+	s := newTestService(t, WithLogger(logger))
+	all, _ := eventSub(t, s, "admin-1", WILDCARD_AGENT_ID)
+
+	s.broadcastEvents("agent-1", nil)
+	s.broadcastEvents("", &proto.EventData{EventType: "usb"})
+	s.broadcastEvents(WILDCARD_AGENT_ID, &proto.EventData{EventType: "usb"})
+	all.expectNone(t)
+	if got := len(logs.withEvent("invalid_event")); got != 3 {
+		t.Fatalf("invalid_event 로그 = %d, want 3", got)
+	}
+	if got := s.eventReplay.snapshot(""); len(got) != 0 {
+		t.Fatalf("잘못된 이벤트가 리플레이에 기록됨: %v", got)
+	}
+
+	// AgentId 가 빈 이벤트는 라우팅 대상 Agent 로 채워 전달 (원본은 수정하지 않음)
+	event := &proto.EventData{EventType: "usb"}
+	s.broadcastEvents("agent-1", event)
+	if got := all.next(t).GetAgentId(); got != "agent-1" {
+		t.Fatalf("보정된 이벤트 AgentId = %q, want agent-1", got)
+	}
+	if event.GetAgentId() != "" {
+		t.Fatal("원본 이벤트가 수정됨")
+	}
+}
@@ -0,0 +1,54 @@
+// filter.go: HandleIncomingFrame 프레임 필터 체인
+// 브로드캐스트 전에 등록된 필터를 순서대로 적용해 프레임을 변환하거나 버립니다.
+// 변환(워터마크 등), 드롭(rate-limit 등) 로직을 조합 가능한 단위로 추가할 수 있습니다.
+
+package server
+
+import (
+	"admin/proto"
+)
+
+// FrameFilter는 프레임을 처리하는 필터입니다.
+// 반환된 프레임이 다음 필터로 전달되며, keep 이 false 이면 해당 프레임은 버려집니다.
+// 입력 프레임은 다른 곳과 공유될 수 있으므로 수정이 필요하면 복사본을 반환해야 합니다.
+// 입력 프레임의 AgentId 는 이미 정규화되어 있습니다. (WithAgentIdNormalizer)
+type FrameFilter interface {
+	Process(frame *proto.FrameData) (out *proto.FrameData, keep bool)
+}
+
+// FrameFilterFunc는 함수를 FrameFilter 로 사용하기 위한 어댑터입니다.
+type FrameFilterFunc func(frame *proto.FrameData) (*proto.FrameData, bool)
+
+// Process는 f(frame)을 호출합니다.
+func (f FrameFilterFunc) Process(frame *proto.FrameData) (*proto.FrameData, bool) {
+	return f(frame)
+}
+
+// WithFrameFilters는 생성 시 프레임 필터 체인을 등록합니다.
+func WithFrameFilters(filters ...FrameFilter) Option {
+	return func(s *AdminService) {
+		s.filters = append(s.filters, filters...)
+	}
+}
+
+// UseFrameFilter는 실행 중에 필터를 체인 끝에 추가합니다.
+func (s *AdminService) UseFrameFilter(filters ...FrameFilter) {
+	s.filtersMu.Lock()
+	s.filters = append(s.filters, filters...)
+	s.filtersMu.Unlock()
+}
+
+// applyFilters는 필터 체인을 순서대로 적용합니다. 드롭되면 false 를 반환합니다.
+func (s *AdminService) applyFilters(frame *proto.FrameData) (*proto.FrameData, bool) {
+	s.filtersMu.RLock()
+	filters := s.filters
+	s.filtersMu.RUnlock()
+	for _, f := range filters {
+		out, keep := f.Process(frame)
+		if !keep || out == nil {
+			return nil, false
+		}
+		frame = out
+	}
+	return frame, true
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func TestFrameFilterChain(t *testing.T) {
+	var seen []string
+	passthrough := FrameFilterFunc(func(frame *proto.FrameData) (*proto.FrameData, bool) {
+		seen = append(seen, frame.GetAgentId())
+		return frame, true
+	})
+	drop := FrameFilterFunc(func(frame *proto.FrameData) (*proto.FrameData, bool) {
+		return frame, frame.GetAgentId() != "blocked"
+	})
+	watermark := FrameFilterFunc(func(frame *proto.FrameData) (*proto.FrameData, bool) {
+		out := gproto.Clone(frame).(*proto.FrameData)
+		out.ImageData = append(out.ImageData, []byte("+wm")...)
+		return out, true
+	})
+	s := newTestService(t, WithFrameFilters(passthrough, drop))
+	s.UseFrameFilter(watermark)
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "blocked", ImageData: []byte("img")})
+
+	// 통과 필터는 모든 프레임을 봄
+	if len(seen) != 2 {
+		t.Fatalf("통과 필터 호출 = %v, want 2 회", seen)
+	}
+	if _, ok := s.lastFrames.load("blocked"); ok {
+		t.Fatal("드롭된 프레임이 캐시에 저장됨")
+	}
+	cached, ok := s.lastFrames.load("agent-1")
+	if !ok || string(cached.GetImageData()) != "img+wm" {
+		t.Fatalf("변환된 프레임 = %v, want img+wm", cached)
+	}
+}
+
+func TestFrameFilterNilOutputDrops(t *testing.T) {
+	s := newTestService(t, WithFrameFilters(FrameFilterFunc(func(*proto.FrameData) (*proto.FrameData, bool) {
+		return nil, true
+	})))
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	if _, ok := s.lastFrames.load("agent-1"); ok {
+		t.Fatal("nil 을 반환한 필터의 프레임이 캐시에 저장됨")
+	}
+}
@@ -0,0 +1,55 @@
+// focus.go: Overview 포커스 Agent
+// "Overview 그리드 + 크게 보는 타일 하나" 화면에서 Detail 스트림을 따로 열지 않도록,
+// Overview 구독자가 지정한 포커스 Agent 1개는 미리보기(재압축본) 대신 원본 프레임을 같은 스트림으로 보냅니다.
+// 포커스 Agent 에는 previews_only / stride 를 적용하지 않으며, Agent 필터에 포함되어야 합니다.
+// 구독 요청의 focus_agent_id 로 시작하고 SetOverviewFocus 로 실행 중에 변경합니다.
+
+package server
+
+import (
+	"context"
+	"sync/atomic"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// overviewFocus는 구독자의 포커스 Agent 입니다. (broadcast 경로에서 lock 없이 읽음)
+type overviewFocus struct {
+	agentId atomic.Pointer[string]
+}
+
+// set은 포커스 Agent 를 변경합니다. 빈 문자열이면 해제합니다.
+func (f *overviewFocus) set(agentId string) {
+	if agentId == "" {
+		f.agentId.Store(nil)
+		return
+	}
+	f.agentId.Store(&agentId)
+}
+
+// is는 agentId 가 현재 포커스 Agent 인지 반환합니다.
+func (f *overviewFocus) is(agentId string) bool {
+	p := f.agentId.Load()
+	return p != nil && *p == agentId
+}
+
+// SetOverviewFocus는 실행 중인 Overview 구독의 포커스 Agent 를 변경합니다.
+func (s *AdminService) SetOverviewFocus(ctx context.Context, req *proto.OverviewFocusRequest) (*proto.OverviewFocusResponse, error) {
+	if req.GetAdminId() == "" || req.GetSubscriptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "adminId and subscriptionId are required")
+	}
+	key := overviewKey{adminId: req.GetAdminId(), subscriptionId: req.GetSubscriptionId()}
+	s.mu.RLock()
+	sub, ok := s.overviewSubs[key]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "overview subscription %q not found for admin %q", req.GetSubscriptionId(), req.GetAdminId())
+	}
+	focusAgentId := s.normalizeAgentId(req.GetFocusAgentId())
+	sub.focus.set(focusAgentId)
+	s.logger.Info("Overview 포커스 변경", "event", "overview_focus", "adminId", key.adminId, "subscriptionId", key.subscriptionId, "agentId", focusAgentId)
+	return &proto.OverviewFocusResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"math/rand/v2"
+	"testing"
+
+	"admin/proto"
+)
+
+// noisyPNG는 재압축하면 확실히 작아지도록 픽셀마다 값이 다른 PNG 이미지를 만듭니다.
+func noisyPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	rng := rand.New(rand.NewPCG(1, 2))
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = byte(rng.Uint32())
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// frameWidth는 프레임 이미지의 가로 크기를 반환합니다.
+func frameWidth(t *testing.T, frame *proto.FrameData) int {
+	t.Helper()
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(frame.GetImageData()))
+	if err != nil {
+		t.Fatalf("프레임 디코딩: %v", err)
+	}
+	return cfg.Width
+}
+
+func TestOverviewFocusSwitchesResolution(t *testing.T) {
+	s := newTestService(t, WithPreviewTranscode(20, 20, 80))
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: "main"}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+	img := noisyPNG(t, 80, 60)
+	// push 는 프레임 하나를 넣고 Overview 로 전달된 결과를 받습니다. (Agent 별 병합을 피하려고 하나씩 확인)
+	push := func(agentId string) *proto.FrameData {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: img})
+		return stream.next(t)
+	}
+
+	if got := push("agent-1"); !got.GetIsPreview() || frameWidth(t, got) > 20 {
+		t.Fatalf("포커스 전 프레임 = preview %v width %d, want 축소본", got.GetIsPreview(), frameWidth(t, got))
+	}
+
+	if _, err := s.SetOverviewFocus(context.Background(), &proto.OverviewFocusRequest{AdminId: "admin-1", SubscriptionId: "main", FocusAgentId: "agent-1"}); err != nil {
+		t.Fatalf("SetOverviewFocus: %v", err)
+	}
+	if got := push("agent-1"); got.GetIsPreview() || frameWidth(t, got) != 80 {
+		t.Fatalf("포커스 Agent 프레임 = preview %v width %d, want 원본", got.GetIsPreview(), frameWidth(t, got))
+	}
+	if got := push("agent-2"); !got.GetIsPreview() || frameWidth(t, got) > 20 {
+		t.Fatalf("포커스 밖 Agent 프레임 = preview %v width %d, want 축소본", got.GetIsPreview(), frameWidth(t, got))
+	}
+
+	// Control 로 포커스 해제
+	if err := s.applyControl(&proto.ControlRequest{AdminId: "admin-1", SubscriptionId: "main", Update: &proto.ControlRequest_FocusAgentId{FocusAgentId: ""}}); err != nil {
+		t.Fatalf("applyControl: %v", err)
+	}
+	if got := push("agent-1"); !got.GetIsPreview() || frameWidth(t, got) > 20 {
+		t.Fatalf("포커스 해제 후 프레임 = preview %v width %d, want 축소본", got.GetIsPreview(), frameWidth(t, got))
+	}
+}
+
+func TestOverviewFocusUnknownSubscription(t *testing.T) {
+	s := newTestService(t)
+	_, err := s.SetOverviewFocus(context.Background(), &proto.OverviewFocusRequest{AdminId: "admin-1", SubscriptionId: "missing", FocusAgentId: "agent-1"})
+	if err == nil {
+		t.Fatal("없는 구독의 포커스 변경이 허용됨")
+	}
+}
@@ -0,0 +1,63 @@
+// format.go: 프레임 이미지 형식 판별
+// Agent 가 Format 을 비워 보내면 ImageData 앞부분의 매직 바이트로 JPEG/PNG/WebP 를 판별해 채웁니다.
+// 판별할 수 없는 형식은 IMAGE_FORMAT_UNKNOWN 으로 표시하고 데이터는 그대로 전달합니다.
+// 상태 신호(빈 이미지) 프레임은 UNSPECIFIED 로 둡니다.
+// 파일로 저장하는 싱크는 판별한 형식에 맞는 확장자를 사용합니다.
+
+package server
+
+import (
+	"bytes"
+
+	"admin/proto"
+)
+
+var (
+	// 형식별 시그니처
+	jpegMagic = []byte{0xFF, 0xD8, 0xFF}
+	pngMagic  = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+	riffMagic = []byte("RIFF")
+	webpMagic = []byte("WEBP")
+)
+
+// detectImageFormat은 이미지 데이터의 매직 바이트로 형식을 판별합니다.
+func detectImageFormat(data []byte) proto.ImageFormat {
+	switch {
+	case len(data) == 0:
+		return proto.ImageFormat_IMAGE_FORMAT_UNSPECIFIED
+	case bytes.HasPrefix(data, jpegMagic):
+		return proto.ImageFormat_IMAGE_FORMAT_JPEG
+	case bytes.HasPrefix(data, pngMagic):
+		return proto.ImageFormat_IMAGE_FORMAT_PNG
+	case len(data) >= 12 && bytes.HasPrefix(data, riffMagic) && bytes.Equal(data[8:12], webpMagic):
+		return proto.ImageFormat_IMAGE_FORMAT_WEBP
+	default:
+		return proto.ImageFormat_IMAGE_FORMAT_UNKNOWN
+	}
+}
+
+// assignFormat은 Format 이 비어 있는 프레임에 판별한 형식을 채웁니다.
+func assignFormat(frame *proto.FrameData) {
+	if frame.GetFormat() != proto.ImageFormat_IMAGE_FORMAT_UNSPECIFIED {
+		return
+	}
+	frame.Format = detectImageFormat(frame.GetImageData())
+}
+
+// frameFileExt는 프레임 형식에 맞는 파일 확장자를 반환합니다.
+// Format 이 비어 있으면 데이터로 판별하며, 판별할 수 없으면 ".bin" 입니다.
+func frameFileExt(frame *proto.FrameData) string {
+	format := frame.GetFormat()
+	if format == proto.ImageFormat_IMAGE_FORMAT_UNSPECIFIED {
+		format = detectImageFormat(frame.GetImageData())
+	}
+	switch format {
+	case proto.ImageFormat_IMAGE_FORMAT_JPEG:
+		return ".jpg"
+	case proto.ImageFormat_IMAGE_FORMAT_PNG:
+		return ".png"
+	case proto.ImageFormat_IMAGE_FORMAT_WEBP:
+		return ".webp"
+	}
+	return ".bin"
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestDetectImageFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want proto.ImageFormat
+	}{
+		{"jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10}, proto.ImageFormat_IMAGE_FORMAT_JPEG},
+		{"png", []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n', 0x00}, proto.ImageFormat_IMAGE_FORMAT_PNG},
+		{"webp", []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), proto.ImageFormat_IMAGE_FORMAT_WEBP},
+		{"riff 이지만 webp 아님", []byte("RIFF\x00\x00\x00\x00WAVE"), proto.ImageFormat_IMAGE_FORMAT_UNKNOWN},
+		{"잘린 png", []byte{0x89, 'P', 'N'}, proto.ImageFormat_IMAGE_FORMAT_UNKNOWN},
+		{"빈 이미지", nil, proto.ImageFormat_IMAGE_FORMAT_UNSPECIFIED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectImageFormat(tt.data); got != tt.want {
+				t.Fatalf("detectImageFormat = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncomingFrameFormatAssigned(t *testing.T) {
+	s := newTestService(t)
+	jpeg := &proto.FrameData{AgentId: "agent-1", ImageData: []byte{0xFF, 0xD8, 0xFF, 0xDB}}
+	s.HandleIncomingFrame(jpeg)
+	if jpeg.GetFormat() != proto.ImageFormat_IMAGE_FORMAT_JPEG {
+		t.Fatalf("JPEG 프레임 Format = %v", jpeg.GetFormat())
+	}
+	unknown := &proto.FrameData{AgentId: "agent-2", ImageData: []byte("raw")}
+	s.HandleIncomingFrame(unknown)
+	if unknown.GetFormat() != proto.ImageFormat_IMAGE_FORMAT_UNKNOWN {
+		t.Fatalf("알 수 없는 프레임 Format = %v", unknown.GetFormat())
+	}
+	// Agent 가 지정한 형식은 덮어쓰지 않음
+	declared := &proto.FrameData{AgentId: "agent-3", ImageData: []byte{0xFF, 0xD8, 0xFF}, Format: proto.ImageFormat_IMAGE_FORMAT_PNG}
+	s.HandleIncomingFrame(declared)
+	if declared.GetFormat() != proto.ImageFormat_IMAGE_FORMAT_PNG {
+		t.Fatalf("지정된 Format = %v, want PNG 유지", declared.GetFormat())
+	}
+}
+
+func TestFrameFileExt(t *testing.T) {
+	tests := []struct {
+		frame *proto.FrameData
+		want  string
+	}{
+		{&proto.FrameData{Format: proto.ImageFormat_IMAGE_FORMAT_JPEG}, ".jpg"},
+		{&proto.FrameData{Format: proto.ImageFormat_IMAGE_FORMAT_PNG}, ".png"},
+		{&proto.FrameData{Format: proto.ImageFormat_IMAGE_FORMAT_WEBP}, ".webp"},
+		{&proto.FrameData{Format: proto.ImageFormat_IMAGE_FORMAT_UNKNOWN}, ".bin"},
+		// Format 이 비어 있으면 데이터로 판별
+		{&proto.FrameData{ImageData: pngMagic}, ".png"},
+		{&proto.FrameData{ImageData: []byte("raw")}, ".bin"},
+	}
+	for _, tt := range tests {
+		if got := frameFileExt(tt.frame); got != tt.want {
+			t.Errorf("frameFileExt(%v) = %q, want %q", tt.frame, got, tt.want)
+		}
+	}
+}
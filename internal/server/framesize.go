@@ -0,0 +1,50 @@
+// framesize.go: 최대 프레임 크기 제한
+// 비정상 Agent 가 매우 큰 ImageData 를 보내면 구독자 채널 버퍼(버퍼 크기 × 프레임 크기)로 메모리가 급증하므로,
+// HandleIncomingFrame 에서 제한을 넘는 프레임을 버리고, 가능하면 전송 계층(grpc.MaxRecvMsgSize)에서 먼저 거부합니다.
+
+package server
+
+import (
+	"admin/proto"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// 기본 최대 프레임 이미지 크기 (gRPC 기본 수신 한도와 동일)
+	MAX_FRAME_SIZE_BYTES = 4 << 20
+	// 이미지 외 필드(agentId, timestamp 등)와 protobuf 인코딩 여유분
+	FRAME_MESSAGE_OVERHEAD_BYTES = 64 << 10
+)
+
+// WithMaxFrameSize는 허용하는 최대 프레임 이미지 크기(바이트)를 설정합니다. (기본 MAX_FRAME_SIZE_BYTES)
+// 0 이하이면 HandleIncomingFrame 에서 크기를 검사하지 않습니다.
+func WithMaxFrameSize(n int) Option {
+	return func(s *AdminService) {
+		s.maxFrameSize = n
+	}
+}
+
+// ServerOptions는 서비스 설정에 맞춘 grpc.ServerOption 목록을 반환합니다.
+// grpc.NewServer(svc.ServerOptions()...) 로 등록하면 최대 프레임 크기를 넘는 메시지를 전송 계층에서 거부하고,
+// 클라이언트 keepalive ping 허용 정책을 적용합니다.
+func (s *AdminService) ServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if s.maxFrameSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.maxFrameSize+FRAME_MESSAGE_OVERHEAD_BYTES))
+	}
+	if opt, ok := s.keepaliveServerOption(); ok {
+		opts = append(opts, opt)
+	}
+	return opts
+}
+
+// oversized는 프레임 이미지가 최대 크기를 넘는지 확인하고, 넘으면 기록 후 true 를 반환합니다.
+func (s *AdminService) oversized(frame *proto.FrameData) bool {
+	if s.maxFrameSize <= 0 || len(frame.GetImageData()) <= s.maxFrameSize {
+		return false
+	}
+	s.counters.framesOversize.Add(1)
+	s.logger.Warn("최대 크기 초과 프레임 폐기", "event", "frame_oversize", "agentId", frame.GetAgentId(), "size", len(frame.GetImageData()), "limit", s.maxFrameSize)
+	return true
+}
@@ -0,0 +1,30 @@
+package server_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"admin/internal/server"
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaxFrameSizeEnforcedAtTransport(t *testing.T) {
+	const limit = 1024
+	_, client := startHarness(t, []server.Option{server.WithMaxFrameSize(limit)})
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+
+	if _, err := client.ListAgents(ctx, &proto.ListAgentsRequest{AdminId: strings.Repeat("a", limit)}); err != nil {
+		t.Fatalf("한도 이내 메시지 오류 = %v", err)
+	}
+	// 이미지 한도 + 메시지 여유분을 넘는 요청은 핸들러에 닿기 전에 거부
+	big := strings.Repeat("a", limit+server.FRAME_MESSAGE_OVERHEAD_BYTES+1)
+	_, err := client.ListAgents(ctx, &proto.ListAgentsRequest{AdminId: big})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("한도 초과 메시지 오류 = %v, want ResourceExhausted", err)
+	}
+}
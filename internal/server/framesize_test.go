@@ -0,0 +1,34 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestMaxFrameSizeAcceptsAndRejects(t *testing.T) {
+	const limit = 16
+	s := newTestService(t, WithMaxFrameSize(limit))
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	accepted := bytes.Repeat([]byte("a"), limit)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: accepted, Timestamp: time.Now().UnixMilli()})
+	if got := stream.next(t); !bytes.Equal(got.GetImageData(), accepted) {
+		t.Fatalf("제한 이내 프레임 = %d bytes", len(got.GetImageData()))
+	}
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: bytes.Repeat([]byte("b"), limit+1), Timestamp: time.Now().UnixMilli()})
+	stream.expectNone(t)
+	if cached, _ := s.lastFrames.load("agent-1"); !bytes.Equal(cached.GetImageData(), accepted) {
+		t.Fatal("초과 프레임이 캐시를 덮어씀")
+	}
+	if got := s.Stats().FramesOversize; got != 1 {
+		t.Fatalf("FramesOversize = %d, want 1", got)
+	}
+}
@@ -0,0 +1,57 @@
+// harness_test.go: servertest 하니스 기반 외부 테스트 공용 도우미
+// 실제 gRPC 클라이언트 경로(인터셉터/헤더/직렬화)를 거쳐야 하는 테스트에서 사용합니다.
+
+package server_test
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"admin/internal/server"
+	"admin/internal/server/servertest"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// 테스트에서 RPC/스트림 응답을 기다리는 최대 시간
+	TEST_WAIT_TIMEOUT = 5 * time.Second
+	// 조건을 다시 확인하는 주기
+	TEST_POLL_INTERVAL = 5 * time.Millisecond
+)
+
+// quietLogger는 로그를 버리는 구조화 로거 옵션입니다.
+func quietLogger() server.Option {
+	return server.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// startHarness는 opts 로 만든 AdminService 를 하니스로 띄우고 연결된 클라이언트를 반환합니다. 테스트 종료 시 정리합니다.
+func startHarness(t *testing.T, opts []server.Option, grpcOpts ...grpc.ServerOption) (*servertest.Harness, proto.AdminServiceClient) {
+	t.Helper()
+	h := servertest.Start(server.NewAdminService(append([]server.Option{quietLogger()}, opts...)...), grpcOpts...)
+	client, conn, err := h.Client()
+	if err != nil {
+		h.Close()
+		t.Fatalf("하니스 클라이언트 생성 실패: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		h.Close()
+	})
+	return h, client
+}
+
+// waitUntil은 cond 가 참이 될 때까지 기다립니다. 시간 초과 시 테스트를 실패시킵니다.
+func waitUntil(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(TEST_WAIT_TIMEOUT)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: 시간 초과", what)
+		}
+		time.Sleep(TEST_POLL_INTERVAL)
+	}
+}
@@ -0,0 +1,33 @@
+// health.go: HealthCheck RPC (liveness/readiness)
+// 오케스트레이터 probe 와 클라이언트의 구독 전 사전 확인에 사용합니다.
+// 인증 인터셉터는 이 메서드를 토큰 없이 통과시킵니다. (HEALTH_CHECK_METHOD)
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"admin/proto"
+)
+
+// HealthCheck는 서비스 상태와 현재 구독자 수, 가동 시간을 반환합니다.
+// Shutdown 이후에는 NOT_SERVING 을 반환합니다.
+func (s *AdminService) HealthCheck(ctx context.Context, req *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
+	st := s.Stats()
+	s.mu.RLock()
+	shutdown := s.shutdown
+	s.mu.RUnlock()
+
+	resp := &proto.HealthCheckResponse{
+		Status:              proto.HealthCheckResponse_SERVING,
+		OverviewSubscribers: int32(st.OverviewSubscribers),
+		DetailSubscribers:   int32(st.DetailSubscribers),
+		EventSubscribers:    int32(st.EventSubscribers),
+		UptimeMs:            time.Since(s.startedAt).Milliseconds(),
+	}
+	if shutdown {
+		resp.Status = proto.HealthCheckResponse_NOT_SERVING
+	}
+	return resp, nil
+}
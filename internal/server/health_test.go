@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestHealthCheckCounts(t *testing.T) {
+	s := newTestService(t)
+	for _, adminId := range []string{"admin-1", "admin-2"} {
+		overview := newFakeStream[proto.FrameData](t, 1)
+		serve(func() error {
+			return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: adminId}, overview)
+		})
+	}
+	detail := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, detail)
+	})
+	for _, agentId := range []string{"agent-1", "agent-2", "agent-3"} {
+		events := newFakeStream[proto.EventData](t, 1)
+		serve(func() error {
+			return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: agentId}, events)
+		})
+	}
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().ActiveSubscribers == 6 })
+
+	resp, err := s.HealthCheck(context.Background(), &proto.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != proto.HealthCheckResponse_SERVING || resp.GetOverviewSubscribers() != 2 || resp.GetDetailSubscribers() != 1 || resp.GetEventSubscribers() != 3 {
+		t.Fatalf("HealthCheck = %v, want SERVING 2/1/3", resp)
+	}
+	if resp.GetUptimeMs() < 0 {
+		t.Fatalf("UptimeMs = %d", resp.GetUptimeMs())
+	}
+
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	resp, _ = s.HealthCheck(context.Background(), &proto.HealthCheckRequest{})
+	if resp.GetStatus() != proto.HealthCheckResponse_NOT_SERVING || resp.GetOverviewSubscribers() != 0 {
+		t.Fatalf("Shutdown 후 HealthCheck = %v, want NOT_SERVING", resp)
+	}
+}
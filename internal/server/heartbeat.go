@@ -0,0 +1,91 @@
+// heartbeat.go: 유휴 구독 스트림 keepalive
+// Agent 가 한동안 프레임을 보내지 않아도 로드밸런서/프록시나 클라이언트 read deadline 에 의해
+// 스트림이 끊기지 않도록, 일정 시간 전송이 없으면 가벼운 heartbeat 신호를 보냅니다. (기본 비활성)
+
+package server
+
+import (
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// heartbeat 신호용 특수 타임스탬프 값 (OFFLINE/ONLINE 과 겹치지 않도록 음수 사용)
+	HEARTBEAT_TIMESTAMP = -2
+	// heartbeat 이벤트 타입 (Events 스트림용)
+	HEARTBEAT_EVENT_TYPE = "heartbeat"
+)
+
+// WithHeartbeatInterval은 유휴 스트림에 heartbeat 를 보내는 간격을 설정합니다.
+// 0 이하이면 heartbeat 를 보내지 않습니다. (기본값)
+func WithHeartbeatInterval(d time.Duration) Option {
+	return func(s *AdminService) {
+		s.heartbeatInterval = d
+	}
+}
+
+// newHeartbeatFrame는 heartbeat 신호 FrameData를 생성합니다.
+func newHeartbeatFrame(agentId string) *proto.FrameData {
+	return &proto.FrameData{
+		AgentId:   agentId,
+		ImageData: []byte{},
+		Timestamp: HEARTBEAT_TIMESTAMP,
+		IsPreview: true,
+	}
+}
+
+// isHeartbeatFrame는 주어진 프레임이 heartbeat 신호인지 판단합니다.
+func isHeartbeatFrame(frame *proto.FrameData) bool {
+	if frame == nil {
+		return false
+	}
+	return frame.Timestamp == HEARTBEAT_TIMESTAMP && len(frame.ImageData) == 0
+}
+
+// newHeartbeatEvent는 heartbeat 신호 EventData를 생성합니다.
+func newHeartbeatEvent(agentId string) *proto.EventData {
+	return &proto.EventData{
+		AgentId:   agentId,
+		EventType: HEARTBEAT_EVENT_TYPE,
+		Timestamp: time.Now().UnixMilli(),
+	}
+}
+
+// heartbeatTimer는 마지막 전송 이후 interval 동안 조용하면 발화하는 타이머입니다.
+// interval 이 0 이하이면 C() 가 nil 채널을 반환해 select 에서 선택되지 않습니다.
+type heartbeatTimer struct {
+	interval time.Duration
+	timer    *time.Timer
+}
+
+// newHeartbeatTimer는 heartbeatTimer를 생성합니다.
+func newHeartbeatTimer(interval time.Duration) *heartbeatTimer {
+	h := &heartbeatTimer{interval: interval}
+	if interval > 0 {
+		h.timer = time.NewTimer(interval)
+	}
+	return h
+}
+
+// C는 발화 채널을 반환합니다.
+func (h *heartbeatTimer) C() <-chan time.Time {
+	if h.timer == nil {
+		return nil
+	}
+	return h.timer.C
+}
+
+// reset은 전송이 발생했음을 알리고 타이머를 다시 시작합니다.
+func (h *heartbeatTimer) reset() {
+	if h.timer != nil {
+		h.timer.Reset(h.interval)
+	}
+}
+
+// stop은 타이머를 정지합니다.
+func (h *heartbeatTimer) stop() {
+	if h.timer != nil {
+		h.timer.Stop()
+	}
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestHeartbeatsDuringSilence(t *testing.T) {
+	s := newTestService(t, WithHeartbeatInterval(20*time.Millisecond))
+	overview := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, overview)
+	})
+	detail := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, detail)
+	})
+	events := newFakeStream[proto.EventData](t, 8)
+	serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, events)
+	})
+
+	// 프레임/이벤트가 전혀 없어도 간격마다 heartbeat 가 전송됨
+	for range 2 {
+		if f := overview.next(t); !isHeartbeatFrame(f) {
+			t.Fatalf("Overview 유휴 전송 = %v, want heartbeat", f)
+		}
+		if f := detail.next(t); !isHeartbeatFrame(f) || f.GetAgentId() != "agent-1" {
+			t.Fatalf("Detail 유휴 전송 = %v, want agent-1 heartbeat", f)
+		}
+		if e := events.next(t); e.GetEventType() != HEARTBEAT_EVENT_TYPE {
+			t.Fatalf("Events 유휴 전송 = %v, want heartbeat", e)
+		}
+	}
+}
+
+func TestNoHeartbeatByDefault(t *testing.T) {
+	s := newTestService(t)
+	overview := newFakeStream[proto.FrameData](t, 1)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, overview)
+	})
+	overview.expectNone(t)
+}
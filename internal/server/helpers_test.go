@@ -0,0 +1,191 @@
+// helpers_test.go: 패키지 내부 테스트 공용 도우미
+// 실제 gRPC 연결 없이 구독 핸들러를 직접 호출할 수 있도록 서버 스트림을 흉내 냅니다.
+
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// 테스트에서 비동기 상태 변화를 기다리는 최대 시간
+	TEST_WAIT_TIMEOUT = 5 * time.Second
+	// 조건을 다시 확인하는 주기
+	TEST_POLL_INTERVAL = 5 * time.Millisecond
+	// 전송되지 않아야 하는 메시지를 기다리는 시간
+	TEST_QUIET_PERIOD = 100 * time.Millisecond
+)
+
+// fakeStream은 grpc.ServerStreamingServer[T] 를 흉내 내는 테스트용 스트림입니다.
+// Send 한 메시지는 sent 채널로 전달되며, 읽는 쪽이 없으면 ctx 가 끝날 때까지 막힙니다.
+type fakeStream[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   chan *T
+	header metadata.MD
+}
+
+// newFakeStream은 buffer 크기의 전송 채널을 가진 fakeStream 을 생성합니다. 테스트 종료 시 context 를 취소합니다.
+func newFakeStream[T any](t testing.TB, buffer int) *fakeStream[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &fakeStream[T]{ctx: ctx, cancel: cancel, sent: make(chan *T, buffer)}
+}
+
+func (f *fakeStream[T]) Send(m *T) error {
+	select {
+	case f.sent <- m:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func (f *fakeStream[T]) Context() context.Context { return f.ctx }
+
+func (f *fakeStream[T]) SetHeader(md metadata.MD) error {
+	f.header = metadata.Join(f.header, md)
+	return nil
+}
+
+func (f *fakeStream[T]) SendHeader(metadata.MD) error { return nil }
+func (f *fakeStream[T]) SetTrailer(metadata.MD)       {}
+func (f *fakeStream[T]) SendMsg(any) error            { return nil }
+func (f *fakeStream[T]) RecvMsg(any) error            { return io.EOF }
+
+// next는 timeout 안에 전송된 다음 메시지를 반환합니다. 없으면 테스트를 실패시킵니다.
+func (f *fakeStream[T]) next(t *testing.T) *T {
+	t.Helper()
+	select {
+	case m := <-f.sent:
+		return m
+	case <-time.After(TEST_WAIT_TIMEOUT):
+		t.Fatal("전송된 메시지 대기 시간 초과")
+		return nil
+	}
+}
+
+// discard는 이후 전송되는 메시지를 스트림 context 가 끝날 때까지 읽어 버립니다. (테스트 종료 시 정리)
+func (f *fakeStream[T]) discard() {
+	go func() {
+		for {
+			select {
+			case <-f.sent:
+			case <-f.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// expectNone은 TEST_QUIET_PERIOD 동안 메시지가 전송되지 않았는지 확인합니다.
+func (f *fakeStream[T]) expectNone(t *testing.T) {
+	t.Helper()
+	select {
+	case m := <-f.sent:
+		t.Fatalf("예상하지 않은 메시지 전송: %v", m)
+	case <-time.After(TEST_QUIET_PERIOD):
+	}
+}
+
+// newTestService는 로그를 버리는 AdminService 를 생성하고 테스트 종료 시 Shutdown 합니다.
+func newTestService(t testing.TB, opts ...Option) *AdminService {
+	t.Helper()
+	opts = append([]Option{WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))}, opts...)
+	s := NewAdminService(opts...)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+		defer cancel()
+		_ = s.Shutdown(ctx)
+	})
+	return s
+}
+
+// serve는 구독 핸들러를 고루틴으로 실행하고 반환 오류를 전달하는 채널을 돌려줍니다.
+func serve(fn func() error) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn()
+	}()
+	return errCh
+}
+
+// waitErr는 핸들러가 timeout 안에 끝나기를 기다려 반환 오류를 돌려줍니다.
+func waitErr(t *testing.T, errCh <-chan error) error {
+	t.Helper()
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(TEST_WAIT_TIMEOUT):
+		t.Fatal("구독 핸들러 종료 대기 시간 초과")
+		return nil
+	}
+}
+
+// waitUntil은 cond 가 참이 될 때까지 기다립니다. 시간 초과 시 테스트를 실패시킵니다.
+func waitUntil(t testing.TB, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(TEST_WAIT_TIMEOUT)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: 시간 초과", what)
+		}
+		time.Sleep(TEST_POLL_INTERVAL)
+	}
+}
+
+// logRecord는 captureHandler 가 기록한 로그 한 줄입니다.
+type logRecord struct {
+	level   slog.Level
+	message string
+	attrs   map[string]any
+}
+
+// captureHandler는 로그 레코드를 메모리에 모으는 slog.Handler 입니다.
+type captureHandler struct {
+	mu      *sync.Mutex
+	records *[]logRecord
+}
+
+// newCaptureLogger는 captureHandler 를 쓰는 로거와 핸들러를 생성합니다.
+func newCaptureLogger() (*slog.Logger, *captureHandler) {
+	h := &captureHandler{mu: &sync.Mutex{}, records: &[]logRecord{}}
+	return slog.New(h), h
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	rec := logRecord{level: r.Level, message: r.Message, attrs: make(map[string]any)}
+	r.Attrs(func(a slog.Attr) bool {
+		rec.attrs[a.Key] = a.Value.Any()
+		return true
+	})
+	h.mu.Lock()
+	*h.records = append(*h.records, rec)
+	h.mu.Unlock()
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+// withEvent는 event 필드가 event 인 레코드를 반환합니다.
+func (h *captureHandler) withEvent(event string) []logRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var out []logRecord
+	for _, rec := range *h.records {
+		if rec.attrs["event"] == event {
+			out = append(out, rec)
+		}
+	}
+	return out
+}
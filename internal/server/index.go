@@ -0,0 +1,44 @@
+// index.go: Agent 기준 구독자 보조 인덱스
+// detailSubs 는 Admin 기준(adminId -> agentId -> sub)이라 프레임마다 전체 Admin 을 순회해야 하므로,
+// agentId -> 구독자 집합 인덱스를 같은 mu 아래에서 함께 갱신해 broadcast 를 해당 Agent 시청자 수에 비례하게 합니다.
+// Events(eventSubs/eventIndex)도 같은 방식이며, Admin 별 정리/개수 제한은 계속 Admin 기준 맵을 사용합니다.
+
+package server
+
+// subscriberIndex는 agentId 별 구독자 집합입니다. (AdminService.mu 로 보호)
+type subscriberIndex map[string]map[*adminSubscriber]struct{}
+
+// add는 구독자를 Agent 인덱스에 추가합니다.
+func (idx subscriberIndex) add(agentId string, sub *adminSubscriber) {
+	subs, ok := idx[agentId]
+	if !ok {
+		subs = make(map[*adminSubscriber]struct{})
+		idx[agentId] = subs
+	}
+	subs[sub] = struct{}{}
+}
+
+// remove는 구독자를 Agent 인덱스에서 제거합니다. 없으면 아무 것도 하지 않습니다.
+func (idx subscriberIndex) remove(agentId string, sub *adminSubscriber) {
+	subs, ok := idx[agentId]
+	if !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(idx, agentId)
+	}
+}
+
+// list는 Agent 를 구독 중인 구독자 목록을 복사해 반환합니다. (lock 밖 전송용)
+func (idx subscriberIndex) list(agentId string) []*adminSubscriber {
+	subs := idx[agentId]
+	if len(subs) == 0 {
+		return nil
+	}
+	out := make([]*adminSubscriber, 0, len(subs))
+	for sub := range subs {
+		out = append(out, sub)
+	}
+	return out
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+// 인덱스 벤치마크 규모: 많은 Admin 이 소수 Agent 를 나누어 시청
+const (
+	BENCH_ADMINS = 1000
+	BENCH_AGENTS = 10
+)
+
+// populateDetail은 핸들러 없이 Admin 마다 Agent 하나를 시청하는 Detail 구독자를 등록합니다.
+func populateDetail(s *AdminService, admins, agents int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range admins {
+		adminId, agentId := fmt.Sprintf("admin-%d", i), fmt.Sprintf("agent-%d", i%agents)
+		sub := newAdminSubscriber(adminId, 1)
+		s.detailSubs[adminId] = map[string]*adminSubscriber{agentId: sub}
+		s.detailIndex.add(agentId, sub)
+	}
+}
+
+// populateEvents는 핸들러 없이 Admin 마다 Agent 하나의 이벤트를 받는 Events 구독자를 등록합니다.
+func populateEvents(s *AdminService, admins, agents int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range admins {
+		adminId, agentId := fmt.Sprintf("admin-%d", i), fmt.Sprintf("agent-%d", i%agents)
+		sub := newAdminSubscriber(adminId, 1)
+		s.eventSubs[adminId] = map[string]*adminSubscriber{agentId: sub}
+		s.eventIndex.add(agentId, sub)
+	}
+}
+
+// scanDetail은 인덱스 도입 전 방식대로 전체 Admin 맵을 순회해 Agent 시청자를 찾습니다. (비교 기준)
+func scanDetail(s *AdminService, agentId string) []*adminSubscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var subs []*adminSubscriber
+	for _, byAgent := range s.detailSubs {
+		if sub, ok := byAgent[agentId]; ok {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// BenchmarkDetailSubscriberLookup은 전체 순회와 Agent 인덱스의 시청자 조회 비용을 비교합니다.
+func BenchmarkDetailSubscriberLookup(b *testing.B) {
+	s := newTestService(b)
+	populateDetail(s, BENCH_ADMINS, BENCH_AGENTS)
+	b.Run("scan", func(b *testing.B) {
+		for i := range b.N {
+			scanDetail(s, fmt.Sprintf("agent-%d", i%BENCH_AGENTS))
+		}
+	})
+	b.Run("index", func(b *testing.B) {
+		for i := range b.N {
+			s.mu.RLock()
+			s.detailIndex.list(fmt.Sprintf("agent-%d", i%BENCH_AGENTS))
+			s.mu.RUnlock()
+		}
+	})
+}
+
+// BenchmarkBroadcastDetail은 인덱스 기반 broadcastDetail 한 번의 비용입니다. (구독자 채널이 가득 차 드롭 경로 포함)
+func BenchmarkBroadcastDetail(b *testing.B) {
+	s := newTestService(b, WithSlowConsumerThreshold(0))
+	populateDetail(s, BENCH_ADMINS, BENCH_AGENTS)
+	frame := &proto.FrameData{AgentId: "agent-0", ImageData: []byte("img")}
+	b.ResetTimer()
+	for range b.N {
+		s.broadcastDetail("agent-0", frame)
+	}
+}
+
+func TestDetailIndexMatchesScan(t *testing.T) {
+	s := newTestService(t)
+	populateDetail(s, 50, 5)
+	for i := range 5 {
+		agentId := fmt.Sprintf("agent-%d", i)
+		s.mu.RLock()
+		indexed := len(s.detailIndex.list(agentId))
+		s.mu.RUnlock()
+		if scanned := len(scanDetail(s, agentId)); indexed != scanned || indexed != 10 {
+			t.Fatalf("%s 시청자 index=%d scan=%d, want 10", agentId, indexed, scanned)
+		}
+	}
+}
+
+// BenchmarkBroadcastEvents는 인덱스 기반 broadcastEvents 한 번의 비용입니다. (구독자 채널이 가득 차 드롭 경로 포함)
+func BenchmarkBroadcastEvents(b *testing.B) {
+	s := newTestService(b, WithSlowConsumerThreshold(0), WithEventReplaySize(0))
+	populateEvents(s, BENCH_ADMINS, BENCH_AGENTS)
+	event := &proto.EventData{AgentId: "agent-0", EventType: "usb"}
+	b.ResetTimer()
+	for range b.N {
+		s.broadcastEvents("agent-0", event)
+	}
+}
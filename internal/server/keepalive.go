@@ -0,0 +1,37 @@
+// keepalive.go: gRPC keepalive 서버 정책
+// 클라이언트가 수 초 간격 keepalive ping 으로 반쯤 끊긴 연결을 빨리 감지할 수 있도록,
+// 그 간격을 허용하는 enforcement policy 를 둡니다. (기본 정책 5분보다 짧은 ping 은 GOAWAY 로 끊김)
+
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// 클라이언트 keepalive ping 허용 최소 간격 (클라이언트 기본 10초보다 짧게)
+	KEEPALIVE_MIN_PING_INTERVAL = 5 * time.Second
+)
+
+// WithKeepaliveMinTime은 클라이언트 keepalive ping 허용 최소 간격을 설정합니다. (기본 KEEPALIVE_MIN_PING_INTERVAL)
+// 0 이하이면 gRPC 기본 정책을 사용합니다.
+func WithKeepaliveMinTime(d time.Duration) Option {
+	return func(s *AdminService) {
+		s.keepaliveMinTime = d
+	}
+}
+
+// keepaliveServerOption은 ServerOptions 에 포함할 keepalive 정책을 반환합니다. 설정이 없으면 false 입니다.
+func (s *AdminService) keepaliveServerOption() (grpc.ServerOption, bool) {
+	if s.keepaliveMinTime <= 0 {
+		return nil, false
+	}
+	return grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime: s.keepaliveMinTime,
+		// 구독 스트림이 없는 연결(헬스체크 직후 등)에서도 ping 허용
+		PermitWithoutStream: true,
+	}), true
+}
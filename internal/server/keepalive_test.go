@@ -0,0 +1,27 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeepaliveServerOption(t *testing.T) {
+	s := newTestService(t)
+	if _, ok := s.keepaliveServerOption(); !ok {
+		t.Fatal("기본 설정에서 keepalive 정책이 없음")
+	}
+	base := len(s.ServerOptions())
+
+	disabled := newTestService(t, WithKeepaliveMinTime(0))
+	if _, ok := disabled.keepaliveServerOption(); ok {
+		t.Fatal("WithKeepaliveMinTime(0) 인데 keepalive 정책이 있음")
+	}
+	if got := len(disabled.ServerOptions()); got != base-1 {
+		t.Fatalf("keepalive 비활성 ServerOptions 수 = %d, want %d", got, base-1)
+	}
+
+	custom := newTestService(t, WithKeepaliveMinTime(time.Second))
+	if custom.keepaliveMinTime != time.Second {
+		t.Fatalf("keepaliveMinTime = %s", custom.keepaliveMinTime)
+	}
+}
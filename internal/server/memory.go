@@ -0,0 +1,105 @@
+// memory.go: 구독자 채널 버퍼 메모리 총량 추정 및 상한 관리
+// 채널 깊이 × 프레임 크기 × 구독자 수만큼 메모리가 쌓일 수 있으므로, Detail 채널에 적재된 이미지 바이트를
+// 구독자별/전체로 누적하고 전체가 상한을 넘으면 가장 많이 밀린 구독자부터 오래된 프레임을 버립니다.
+// Overview 는 Agent 별 최신 1장만 병합 보관하고 이벤트는 작으므로 집계하지 않습니다.
+//
+// 정확성: 적재(+n)와 꺼냄(-n)을 항상 같은 프레임 단위로 짝지어 기록합니다.
+// 종료된 구독자의 남은 버퍼는 종료 후 비우면서 차감하고, 종료 이후 늦게 적재한 쪽은 스스로 비웁니다.
+
+package server
+
+import (
+	"sort"
+
+	"admin/proto"
+)
+
+const (
+	// Detail 채널 버퍼 전체 바이트 soft limit 기본값
+	MAX_BUFFERED_FRAME_BYTES = 256 << 20
+)
+
+// WithMaxBufferedBytes는 Detail 구독자 채널에 쌓인 프레임 바이트 합계의 soft limit 을 설정합니다.
+// (기본 MAX_BUFFERED_FRAME_BYTES) 초과 시 가장 많이 밀린 구독자의 오래된 프레임부터 버리며, 0 이하이면 제한하지 않습니다.
+func WithMaxBufferedBytes(n int64) Option {
+	return func(s *AdminService) {
+		s.maxBufferedBytes = n
+	}
+}
+
+// frameBytes는 메모리 집계에 사용하는 프레임 크기입니다. (이미지 데이터 기준 근사치)
+func frameBytes(frame *proto.FrameData) int64 {
+	return int64(len(frame.GetImageData()))
+}
+
+// trackEnqueued는 frameChan 에 적재된 프레임 크기를 누적합니다.
+// 이미 종료된 구독자에 늦게 적재되었다면 종료 처리의 비우기가 끝났을 수 있으므로 직접 비웁니다.
+func (s *AdminService) trackEnqueued(sub *adminSubscriber, frame *proto.FrameData) {
+	n := frameBytes(frame)
+	sub.bufferedBytes.Add(n)
+	s.bufferedBytes.Add(n)
+	select {
+	case <-sub.done:
+		s.releaseBuffered(sub)
+	default:
+	}
+}
+
+// trackDequeued는 frameChan 에서 꺼낸 프레임 크기를 차감합니다.
+func (s *AdminService) trackDequeued(sub *adminSubscriber, frame *proto.FrameData) {
+	n := frameBytes(frame)
+	sub.bufferedBytes.Add(-n)
+	s.bufferedBytes.Add(-n)
+}
+
+// releaseBuffered는 종료된 구독자의 채널에 남은 프레임을 비우며 차감합니다.
+func (s *AdminService) releaseBuffered(sub *adminSubscriber) {
+	for {
+		select {
+		case frame := <-sub.frames():
+			s.trackDequeued(sub, frame)
+		default:
+			return
+		}
+	}
+}
+
+// enforceMemoryLimit은 전체 적재 바이트가 상한을 넘으면 적재량이 큰 Detail 구독자부터
+// 가장 오래된 프레임을 버려 상한 아래로 되돌립니다.
+func (s *AdminService) enforceMemoryLimit() {
+	if s.maxBufferedBytes <= 0 || s.bufferedBytes.Load() <= s.maxBufferedBytes {
+		return
+	}
+	s.mu.RLock()
+	var subs []*adminSubscriber
+	for _, byAgent := range s.detailSubs {
+		for _, sub := range byAgent {
+			subs = append(subs, sub)
+		}
+	}
+	s.mu.RUnlock()
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].bufferedBytes.Load() > subs[j].bufferedBytes.Load()
+	})
+	for _, sub := range subs {
+		for s.bufferedBytes.Load() > s.maxBufferedBytes {
+			select {
+			case frame := <-sub.frames():
+				s.trackDequeued(sub, frame)
+				sub.totalDrops.Add(1)
+				s.counters.framesShed.Add(1)
+				continue
+			default:
+			}
+			break
+		}
+		if s.bufferedBytes.Load() <= s.maxBufferedBytes {
+			return
+		}
+	}
+}
+
+// BufferedBytes는 현재 Detail 구독자 채널에 적재된 프레임 바이트 합계(근사치)를 반환합니다.
+func (s *AdminService) BufferedBytes() int64 {
+	return s.bufferedBytes.Load()
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestBufferedBytesStayUnderLimit(t *testing.T) {
+	const (
+		frameSize = 1 << 10
+		limit     = 4 * frameSize
+	)
+	s := newTestService(t, WithBufferSize(64), WithSlowConsumerThreshold(0), WithMaxBufferedBytes(limit))
+	// 읽지 않는 Detail 스트림: 첫 프레임은 핸들러가 Send 에서 붙잡고 이후 프레임은 채널에 쌓임
+	stream := newFakeStream[proto.FrameData](t, 0)
+	errCh := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	sub := detailSub(s, "admin-1", "agent-1")
+
+	image := bytes.Repeat([]byte("x"), frameSize)
+	for range 40 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: image})
+		if got := s.Stats().BufferedBytes; got > limit {
+			t.Fatalf("BufferedBytes = %d, want <= %d", got, limit)
+		}
+	}
+	st := s.Stats()
+	if st.FramesShed == 0 {
+		t.Fatal("상한 초과에도 FramesShed = 0")
+	}
+	if depth := len(sub.frames()); depth > limit/frameSize {
+		t.Fatalf("느린 구독자 적재 = %d, want <= %d", depth, limit/frameSize)
+	}
+
+	// 구독 종료 후 남은 버퍼는 집계에서 빠짐
+	stream.cancel()
+	waitErr(t, errCh)
+	if got := s.Stats().BufferedBytes; got != 0 {
+		t.Fatalf("종료 후 BufferedBytes = %d, want 0", got)
+	}
+}
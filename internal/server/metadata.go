@@ -0,0 +1,129 @@
+// metadata.go: Agent 메타데이터 (표시 이름 / 태그)
+// 서버는 Agent ID 만 알기 때문에 운영자가 별도로 붙인 이름과 태그(위치 등)를 보관해 ListAgents 응답에 포함합니다.
+// WithAgentMetadataFile 로 경로를 지정하면 시작 시 JSON 파일에서 읽고, 변경될 때마다 다시 저장해 재시작 후에도 유지합니다.
+// 저장은 임시 파일에 쓴 뒤 rename 하여 도중에 중단되어도 기존 파일이 깨지지 않게 합니다.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// AgentMetadata는 운영자가 Agent 에 붙인 표시 정보입니다.
+type AgentMetadata struct {
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// clone은 호출자가 수정해도 저장된 값이 바뀌지 않도록 태그 맵을 복사합니다.
+func (m AgentMetadata) clone() AgentMetadata {
+	m.Tags = maps.Clone(m.Tags)
+	return m
+}
+
+// WithAgentMetadataFile은 Agent 메타데이터를 보관할 JSON 파일 경로를 설정합니다.
+// 비어 있으면 메모리에만 보관합니다. (기본값)
+func WithAgentMetadataFile(path string) Option {
+	return func(s *AdminService) {
+		s.metadata.path = path
+	}
+}
+
+// agentMetadataStore는 Agent 별 메타데이터와 저장 파일 경로입니다.
+type agentMetadataStore struct {
+	mu   sync.RWMutex
+	path string
+	byId map[string]AgentMetadata
+}
+
+// load는 저장 파일에서 메타데이터를 읽습니다. 파일이 없으면 빈 상태로 시작합니다.
+func (m *agentMetadataStore) load() error {
+	m.byId = make(map[string]AgentMetadata)
+	if m.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read agent metadata: %w", err)
+	}
+	if err := json.Unmarshal(data, &m.byId); err != nil {
+		return fmt.Errorf("parse agent metadata: %w", err)
+	}
+	return nil
+}
+
+// saveLocked는 현재 메타데이터를 저장 파일에 씁니다. (mu 잠금 상태에서 호출)
+func (m *agentMetadataStore) saveLocked() error {
+	if m.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(m.byId, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode agent metadata: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("write agent metadata: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write agent metadata: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write agent metadata: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), m.path); err != nil {
+		return fmt.Errorf("write agent metadata: %w", err)
+	}
+	return nil
+}
+
+// get은 Agent 메타데이터 복사본을 반환합니다.
+func (m *agentMetadataStore) get(agentId string) (AgentMetadata, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	md, ok := m.byId[agentId]
+	return md.clone(), ok
+}
+
+// SetAgentMetadata는 Agent 메타데이터를 설정하고 저장 파일에 반영합니다.
+// 이름과 태그가 모두 비어 있으면 삭제합니다. 저장에 실패하면 이전 값으로 되돌리고 에러를 반환합니다.
+func (s *AdminService) SetAgentMetadata(agentId string, md AgentMetadata) error {
+	agentId = s.normalizeAgentId(agentId)
+	if agentId == "" {
+		return errors.New("agentId is required")
+	}
+	s.metadata.mu.Lock()
+	defer s.metadata.mu.Unlock()
+	prev, existed := s.metadata.byId[agentId]
+	if md.Name == "" && len(md.Tags) == 0 {
+		delete(s.metadata.byId, agentId)
+	} else {
+		s.metadata.byId[agentId] = md.clone()
+	}
+	if err := s.metadata.saveLocked(); err != nil {
+		if existed {
+			s.metadata.byId[agentId] = prev
+		} else {
+			delete(s.metadata.byId, agentId)
+		}
+		return err
+	}
+	s.logger.Info("Agent 메타데이터 변경", "event", "agent_metadata", "agentId", agentId, "name", md.Name)
+	return nil
+}
+
+// GetAgentMetadata는 Agent 메타데이터를 반환합니다. 없으면 false 입니다.
+func (s *AdminService) GetAgentMetadata(agentId string) (AgentMetadata, bool) {
+	return s.metadata.get(s.normalizeAgentId(agentId))
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestAgentMetadataSetGet(t *testing.T) {
+	s := newTestService(t)
+	tags := map[string]string{"location": "3F"}
+	if err := s.SetAgentMetadata("agent-1", AgentMetadata{Name: "Lobby", Tags: tags}); err != nil {
+		t.Fatalf("SetAgentMetadata: %v", err)
+	}
+	// 호출자가 넘긴 맵을 바꿔도 저장된 값은 유지
+	tags["location"] = "changed"
+
+	md, ok := s.GetAgentMetadata("agent-1")
+	if !ok || md.Name != "Lobby" || md.Tags["location"] != "3F" {
+		t.Fatalf("GetAgentMetadata = %+v, %v, want Lobby/3F", md, ok)
+	}
+	// 이름과 태그가 모두 비면 삭제
+	if err := s.SetAgentMetadata("agent-1", AgentMetadata{}); err != nil {
+		t.Fatalf("SetAgentMetadata 삭제: %v", err)
+	}
+	if _, ok := s.GetAgentMetadata("agent-1"); ok {
+		t.Fatal("빈 메타데이터 설정 후에도 남아 있음")
+	}
+	if err := s.SetAgentMetadata("", AgentMetadata{Name: "x"}); err == nil {
+		t.Fatal("빈 agentId 가 허용됨")
+	}
+}
+
+func TestAgentMetadataInListAgents(t *testing.T) {
+	s := newTestService(t)
+	if err := s.SetAgentMetadata("agent-1", AgentMetadata{Name: "Lobby", Tags: map[string]string{"floor": "1"}}); err != nil {
+		t.Fatalf("SetAgentMetadata: %v", err)
+	}
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+
+	resp, err := s.ListAgents(context.Background(), &proto.ListAgentsRequest{AdminId: "admin-1"})
+	if err != nil {
+		t.Fatalf("ListAgents: %v", err)
+	}
+	if len(resp.GetAgents()) != 1 {
+		t.Fatalf("Agent 수 = %d, want 1", len(resp.GetAgents()))
+	}
+	agent := resp.GetAgents()[0]
+	if agent.GetName() != "Lobby" || agent.GetTags()["floor"] != "1" {
+		t.Fatalf("ListAgents 메타데이터 = %q %v, want Lobby floor=1", agent.GetName(), agent.GetTags())
+	}
+}
+
+func TestAgentMetadataPersistsAcrossRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agents.json")
+	first := newTestService(t, WithAgentMetadataFile(path))
+	if err := first.SetAgentMetadata("agent-1", AgentMetadata{Name: "Lobby", Tags: map[string]string{"floor": "1"}}); err != nil {
+		t.Fatalf("SetAgentMetadata: %v", err)
+	}
+
+	second := newTestService(t, WithAgentMetadataFile(path))
+	md, ok := second.GetAgentMetadata("agent-1")
+	if !ok || md.Name != "Lobby" || md.Tags["floor"] != "1" {
+		t.Fatalf("재시작 후 메타데이터 = %+v, %v, want Lobby floor=1", md, ok)
+	}
+}
+
+func TestAgentMetadataSaveFailureRollsBack(t *testing.T) {
+	// 없는 디렉터리에는 임시 파일을 만들 수 없어 저장이 실패함
+	s := newTestService(t, WithAgentMetadataFile(filepath.Join(t.TempDir(), "missing", "agents.json")))
+	if err := s.SetAgentMetadata("agent-1", AgentMetadata{Name: "Lobby"}); err == nil {
+		t.Fatal("저장 실패가 보고되지 않음")
+	}
+	if _, ok := s.GetAgentMetadata("agent-1"); ok {
+		t.Fatal("저장 실패 후 값이 남아 있음")
+	}
+}
@@ -0,0 +1,101 @@
+// metrics.go: Prometheus 텍스트 형식 지표 노출
+// Stats()/AgentRates() 를 스크레이프 시점에 읽어 exposition format(text/plain 0.0.4)으로 직접 렌더링합니다.
+// 외부 의존성 없이 http.Handle("/metrics", svc.MetricsHandler()) 로 연결해 사용합니다.
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	// 지표 이름 접두어
+	METRICS_NAMESPACE = "admin"
+)
+
+// MetricsHandler는 AdminService 지표를 Prometheus 텍스트 형식으로 응답하는 핸들러를 반환합니다.
+func (s *AdminService) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		s.writeMetrics(w)
+	})
+}
+
+// writeMetrics는 현재 지표 스냅샷을 w 에 기록합니다.
+func (s *AdminService) writeMetrics(w io.Writer) {
+	st := s.Stats()
+
+	writeMetricHeader(w, "subscribers", "gauge", "Active subscribers by kind.")
+	writeMetric(w, "subscribers", `kind="overview"`, float64(st.OverviewSubscribers))
+	writeMetric(w, "subscribers", `kind="detail"`, float64(st.DetailSubscribers))
+	writeMetric(w, "subscribers", `kind="events"`, float64(st.EventSubscribers))
+
+	writeMetricHeader(w, "detail_subscribers_by_agent", "gauge", "Active detail subscribers per agent.")
+	for _, agentId := range sortedKeys(st.DetailSubscribersByAgent) {
+		writeMetric(w, "detail_subscribers_by_agent", agentLabel(agentId), float64(st.DetailSubscribersByAgent[agentId]))
+	}
+
+	counters := []struct {
+		name, help string
+		value      uint64
+	}{
+		{"frames_broadcast_total", "Frames delivered to subscriber queues.", st.FramesBroadcast},
+		{"frames_dropped_total", "Frames dropped because a subscriber queue was full.", st.FramesDropped},
+		{"frames_coalesced_total", "Overview frames replaced by a newer frame before sending.", st.FramesCoalesced},
+		{"frames_oversize_total", "Frames rejected for exceeding the size limit.", st.FramesOversize},
+		{"frames_sink_dropped_total", "Frames dropped because the sink queue was full.", st.FramesSinkDropped},
+		{"frames_shed_total", "Buffered frames dropped to stay under the memory limit.", st.FramesShed},
+		{"events_broadcast_total", "Events delivered to subscriber queues.", st.EventsBroadcast},
+		{"events_dropped_total", "Events dropped because a subscriber queue was full.", st.EventsDropped},
+	}
+	for _, c := range counters {
+		writeMetricHeader(w, c.name, "counter", c.help)
+		writeMetric(w, c.name, "", float64(c.value))
+	}
+
+	writeMetricHeader(w, "buffered_bytes", "gauge", "Approximate frame bytes buffered in detail subscriber queues.")
+	writeMetric(w, "buffered_bytes", "", float64(st.BufferedBytes))
+
+	rates := s.AgentRates()
+	writeMetricHeader(w, "agent_frames_per_second", "gauge", "Estimated incoming frames per second per agent (EWMA).")
+	for _, agentId := range sortedKeys(rates) {
+		writeMetric(w, "agent_frames_per_second", agentLabel(agentId), rates[agentId])
+	}
+}
+
+// writeMetricHeader는 HELP/TYPE 주석 줄을 기록합니다.
+func writeMetricHeader(w io.Writer, name, typ, help string) {
+	fmt.Fprintf(w, "# HELP %s_%s %s\n", METRICS_NAMESPACE, name, help)
+	fmt.Fprintf(w, "# TYPE %s_%s %s\n", METRICS_NAMESPACE, name, typ)
+}
+
+// writeMetric은 샘플 한 줄을 기록합니다. labels 는 `k="v"` 형식이며 비어 있으면 생략합니다.
+func writeMetric(w io.Writer, name, labels string, value float64) {
+	if labels != "" {
+		fmt.Fprintf(w, "%s_%s{%s} %g\n", METRICS_NAMESPACE, name, labels, value)
+		return
+	}
+	fmt.Fprintf(w, "%s_%s %g\n", METRICS_NAMESPACE, name, value)
+}
+
+// labelEscaper는 라벨 값의 역슬래시/따옴표/개행을 이스케이프합니다.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// agentLabel은 agent 라벨 문자열을 만듭니다.
+func agentLabel(agentId string) string {
+	return `agent="` + labelEscaper.Replace(agentId) + `"`
+}
+
+// sortedKeys는 출력 순서를 고정하기 위해 맵 키를 정렬해 반환합니다.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
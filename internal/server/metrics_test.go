@@ -0,0 +1,67 @@
+package server
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// scrapeMetrics는 MetricsHandler 응답을 "이름{라벨}" → 값 맵으로 파싱합니다.
+func scrapeMetrics(t *testing.T, s *AdminService) map[string]float64 {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Fatalf("응답 = %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	samples := make(map[string]float64)
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		value, err := strconv.ParseFloat(line[i+1:], 64)
+		if err != nil {
+			t.Fatalf("샘플 값 파싱 실패 %q: %v", line, err)
+		}
+		samples[line[:i]] = value
+	}
+	return samples
+}
+
+func TestMetricsHandlerExposition(t *testing.T) {
+	s := newTestService(t, WithMaxFrameSize(8))
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	stream.next(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("too-large"), Timestamp: time.Now().UnixMilli()})
+
+	samples := scrapeMetrics(t, s)
+	want := map[string]float64{
+		`admin_subscribers{kind="detail"}`:                   1,
+		`admin_subscribers{kind="overview"}`:                 0,
+		`admin_detail_subscribers_by_agent{agent="agent-1"}`: 1,
+		`admin_frames_broadcast_total`:                       1,
+		`admin_frames_oversize_total`:                        1,
+	}
+	for name, value := range want {
+		if got, ok := samples[name]; !ok || got != value {
+			t.Errorf("%s = %v (존재 %v), want %v", name, got, ok, value)
+		}
+	}
+	if _, ok := samples[`admin_agent_frames_per_second{agent="agent-1"}`]; !ok {
+		t.Error("agent_frames_per_second 샘플 없음")
+	}
+}
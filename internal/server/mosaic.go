@@ -0,0 +1,212 @@
+// mosaic.go: Overview 모자이크 합성 모드
+// 타일 수십 개를 UI 에서 각각 그리는 대신, 서버가 주기적으로 Agent 별 최신 프레임을 격자 이미지 한 장으로 합성해 전송합니다.
+// CPU 를 많이 쓰므로 AdminSubscribeRequest.mosaic 를 켠 구독자에게만 적용되며, 해당 구독자는 개별 Agent 프레임을 받지 않습니다.
+// 셀은 agentId 오름차순, 행 우선으로 배치하고 종횡비를 유지해 가운데 맞춥니다. 오프라인/디코딩 불가 Agent 는 빈 셀로 둡니다.
+// Agent 필터가 같은 구독자끼리는 한 번 합성한 이미지를 공유합니다.
+
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 모자이크 프레임의 예약 Agent ID
+	MOSAIC_AGENT_ID = "__mosaic__"
+	// 모자이크 합성 기본 주기
+	MOSAIC_INTERVAL = time.Second
+	// 모자이크 셀 기본 크기 (열 수 0 이면 Agent 수에 맞춰 정사각형에 가깝게 배치)
+	MOSAIC_CELL_WIDTH  = 320
+	MOSAIC_CELL_HEIGHT = 180
+)
+
+// 빈 셀 배경색
+var mosaicBackground = color.RGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xFF}
+
+// mosaicLayout은 모자이크 격자 설정입니다.
+type mosaicLayout struct {
+	columns    int
+	cellWidth  int
+	cellHeight int
+}
+
+// WithMosaicInterval은 모자이크 합성 주기를 설정합니다. (기본 MOSAIC_INTERVAL)
+// 0 이하이면 모자이크 모드를 끄며, mosaic 구독 요청은 FailedPrecondition 으로 거부됩니다.
+func WithMosaicInterval(interval time.Duration) Option {
+	return func(s *AdminService) {
+		s.mosaicInterval = interval
+	}
+}
+
+// WithMosaicLayout은 모자이크 열 수와 셀 크기를 설정합니다.
+// columns 가 0 이하이면 Agent 수에 맞춰 자동 배치하고, 셀 크기가 0 이하이면 기본값(MOSAIC_CELL_WIDTH/HEIGHT)을 사용합니다.
+func WithMosaicLayout(columns, cellWidth, cellHeight int) Option {
+	return func(s *AdminService) {
+		if cellWidth <= 0 {
+			cellWidth = MOSAIC_CELL_WIDTH
+		}
+		if cellHeight <= 0 {
+			cellHeight = MOSAIC_CELL_HEIGHT
+		}
+		s.mosaicLayout = mosaicLayout{columns: max(columns, 0), cellWidth: cellWidth, cellHeight: cellHeight}
+	}
+}
+
+// grid는 Agent 수에 맞는 열/행 수를 반환합니다.
+func (l mosaicLayout) grid(n int) (cols, rows int) {
+	cols = l.columns
+	if cols <= 0 {
+		cols = int(math.Ceil(math.Sqrt(float64(n))))
+	}
+	cols = max(min(cols, n), 1)
+	rows = (n + cols - 1) / cols
+	return cols, rows
+}
+
+// mosaicLoop는 주기적으로 모자이크를 합성해 mosaic 구독자에게 전달합니다. 서비스 context 취소 시 종료합니다.
+func (s *AdminService) mosaicLoop() {
+	ticker := time.NewTicker(s.mosaicInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.broadcastMosaic(now)
+		}
+	}
+}
+
+// broadcastMosaic은 mosaic 구독자별로(같은 Agent 필터는 공유) 모자이크를 합성해 병합 큐에 넣습니다.
+func (s *AdminService) broadcastMosaic(now time.Time) {
+	s.mu.RLock()
+	var subs []*adminSubscriber
+	for _, sub := range s.overviewSubs {
+		if sub.mosaic && !sub.paused.Load() {
+			subs = append(subs, sub)
+		}
+	}
+	s.mu.RUnlock()
+	if len(subs) == 0 {
+		return
+	}
+
+	entries := s.lastFrames.entries()
+	decoded := make(map[string]image.Image)
+	composed := make(map[string]*proto.FrameData)
+	for _, sub := range subs {
+		key := mosaicFilterKey(sub)
+		frame, ok := composed[key]
+		if !ok {
+			frame = s.composeMosaic(entries, sub, decoded, now)
+			composed[key] = frame
+		}
+		if frame == nil {
+			continue
+		}
+		if sub.latest.put(frame) {
+			sub.totalDrops.Add(1)
+			s.counters.framesCoalesced.Add(1)
+		}
+		s.counters.framesBroadcast.Add(1)
+	}
+}
+
+// mosaicFilterKey는 구독자의 Agent 필터를 합성 결과 공유용 키로 변환합니다. (필터 없음은 "")
+func mosaicFilterKey(sub *adminSubscriber) string {
+	filter := sub.agents()
+	if filter == nil {
+		return ""
+	}
+	ids := make([]string, 0, len(filter))
+	for agentId := range filter {
+		ids = append(ids, agentId)
+	}
+	slices.Sort(ids)
+	return "\x00" + strings.Join(ids, "\x00")
+}
+
+// composeMosaic은 구독자가 허용하는 Agent 의 최신 프레임으로 모자이크 프레임을 만듭니다.
+// 대상 Agent 가 없거나 인코딩에 실패하면 nil 을 반환합니다. decoded 는 같은 주기 안의 디코딩 결과 캐시입니다.
+func (s *AdminService) composeMosaic(entries []cachedFrame, sub *adminSubscriber, decoded map[string]image.Image, now time.Time) *proto.FrameData {
+	var frames []*proto.FrameData
+	for _, entry := range entries {
+		if sub.acceptsAgent(entry.frame.GetAgentId()) {
+			frames = append(frames, entry.frame)
+		}
+	}
+	if len(frames) == 0 {
+		return nil
+	}
+	layout := s.mosaicLayout
+	cols, rows := layout.grid(len(frames))
+	dst := image.NewRGBA(image.Rect(0, 0, cols*layout.cellWidth, rows*layout.cellHeight))
+	draw.Draw(dst, dst.Bounds(), &image.Uniform{C: mosaicBackground}, image.Point{}, draw.Src)
+	for i, frame := range frames {
+		src := decodeMosaicCell(frame, decoded)
+		if src == nil {
+			continue
+		}
+		x, y := (i%cols)*layout.cellWidth, (i/cols)*layout.cellHeight
+		drawFitted(dst, image.Rect(x, y, x+layout.cellWidth, y+layout.cellHeight), src)
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: PREVIEW_JPEG_QUALITY}); err != nil {
+		s.logger.Warn("모자이크 인코딩 실패", "event", "mosaic_encode_error", "error", err)
+		return nil
+	}
+	return &proto.FrameData{
+		AgentId:   MOSAIC_AGENT_ID,
+		ImageData: buf.Bytes(),
+		Timestamp: now.UnixMilli(),
+		IsPreview: true,
+		Format:    proto.ImageFormat_IMAGE_FORMAT_JPEG,
+	}
+}
+
+// decodeMosaicCell은 셀에 그릴 이미지를 디코딩합니다. 상태 신호/디코딩 불가 프레임은 nil 입니다.
+func decodeMosaicCell(frame *proto.FrameData, decoded map[string]image.Image) image.Image {
+	agentId := frame.GetAgentId()
+	if img, ok := decoded[agentId]; ok {
+		return img
+	}
+	var img image.Image
+	switch frame.GetFormat() {
+	case proto.ImageFormat_IMAGE_FORMAT_JPEG, proto.ImageFormat_IMAGE_FORMAT_PNG:
+		img, _, _ = image.Decode(bytes.NewReader(frame.GetImageData()))
+	}
+	decoded[agentId] = img
+	return img
+}
+
+// drawFitted는 종횡비를 유지해 cell 안에 가운데 맞춰 그립니다 (nearest-neighbor).
+func drawFitted(dst *image.RGBA, cell image.Rectangle, src image.Image) {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= 0 || h <= 0 {
+		return
+	}
+	dw, dh := cell.Dx(), h*cell.Dx()/w
+	if dh > cell.Dy() {
+		dw, dh = w*cell.Dy()/h, cell.Dy()
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+	ox := cell.Min.X + (cell.Dx()-dw)/2
+	oy := cell.Min.Y + (cell.Dy()-dh)/2
+	for y := 0; y < dh; y++ {
+		sy := b.Min.Y + y*h/dh
+		for x := 0; x < dw; x++ {
+			dst.Set(ox+x, oy+y, src.At(b.Min.X+x*w/dw, sy))
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// solidPNG는 단색 PNG 이미지를 만듭니다.
+func solidPNG(t *testing.T, c color.Color, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestMosaicComposedFromPreviews(t *testing.T) {
+	s := newTestService(t, WithMosaicInterval(20*time.Millisecond), WithMosaicLayout(2, 40, 30))
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: solidPNG(t, color.RGBA{R: 0xFF, A: 0xFF}, 40, 30)})
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-2", ImageData: solidPNG(t, color.RGBA{G: 0xFF, A: 0xFF}, 40, 30)})
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-3", ImageData: solidPNG(t, color.RGBA{B: 0xFF, A: 0xFF}, 40, 30)})
+
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", Mosaic: true}, stream)
+	})
+	frame := stream.next(t)
+	if frame.GetAgentId() != MOSAIC_AGENT_ID || frame.GetFormat() != proto.ImageFormat_IMAGE_FORMAT_JPEG {
+		t.Fatalf("모자이크 구독 프레임 = %q %v, want %q JPEG", frame.GetAgentId(), frame.GetFormat(), MOSAIC_AGENT_ID)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(frame.GetImageData()))
+	if err != nil {
+		t.Fatalf("모자이크 디코딩: %v", err)
+	}
+	// 3 개 Agent, 2 열 → 2x2 격자
+	if b := img.Bounds(); b.Dx() != 80 || b.Dy() != 60 {
+		t.Fatalf("모자이크 크기 = %v, want 80x60", b)
+	}
+	// 셀은 agentId 순 행 우선 배치, 빈 네 번째 셀은 배경색 (JPEG 손실 고려해 주 채널만 확인)
+	cells := []struct {
+		x, y  int
+		check func(r, g, b uint32) bool
+	}{
+		{20, 15, func(r, g, b uint32) bool { return r > 0xC000 && g < 0x4000 && b < 0x4000 }},
+		{60, 15, func(r, g, b uint32) bool { return g > 0xC000 && r < 0x4000 && b < 0x4000 }},
+		{20, 45, func(r, g, b uint32) bool { return b > 0xC000 && r < 0x4000 && g < 0x4000 }},
+		{60, 45, func(r, g, b uint32) bool { return r < 0x4000 && g < 0x4000 && b < 0x4000 }},
+	}
+	for i, cell := range cells {
+		r, g, b, _ := img.At(cell.x, cell.y).RGBA()
+		if !cell.check(r, g, b) {
+			t.Fatalf("셀 %d 색 = (%x, %x, %x)", i, r>>8, g>>8, b>>8)
+		}
+	}
+}
+
+func TestMosaicDisabledRejected(t *testing.T) {
+	s := newTestService(t, WithMosaicInterval(0))
+	err := s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", Mosaic: true}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("모자이크 비활성 구독 오류 = %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,62 @@
+// options.go: AdminService 생성 옵션 (functional options)
+// NewAdminService(WithBufferSize(n), ...) 형태로 기본값을 덮어씁니다.
+
+package server
+
+import "log/slog"
+
+// Option은 AdminService 생성 옵션입니다.
+type Option func(*AdminService)
+
+// WithSlowConsumerThreshold는 느린 소비자 퇴출 기준 연속 드롭 횟수를 설정합니다.
+// 0 이하이면 퇴출하지 않습니다.
+func WithSlowConsumerThreshold(n int) Option {
+	return func(s *AdminService) {
+		s.slowConsumerThreshold = int64(n)
+	}
+}
+
+// WithEventReplaySize는 Agent 별로 보관할 최근 이벤트 개수를 설정합니다.
+// 0 이하이면 리플레이를 사용하지 않습니다.
+func WithEventReplaySize(n int) Option {
+	return func(s *AdminService) {
+		s.eventReplaySize = n
+	}
+}
+
+// WithBufferSize는 구독자 채널 버퍼 크기를 설정합니다. (기본 FRAME_CHANNEL_BUFFER_SIZE)
+// 메모리가 작은 환경에서는 줄이고, 구독자가 많고 순간 부하가 큰 환경에서는 늘립니다.
+// 0 이하 값은 무시합니다.
+func WithBufferSize(n int) Option {
+	return func(s *AdminService) {
+		if n > 0 {
+			s.bufferSize = n
+		}
+	}
+}
+
+// WithMaxDetailSubscriptionsPerAdmin은 Admin 1명이 동시에 열 수 있는 Detail 구독 개수를 제한합니다.
+// (기본 MAX_DETAIL_SUBSCRIPTIONS_PER_ADMIN) 초과 시 ResourceExhausted 로 거부하며, 0 이하이면 제한하지 않습니다.
+func WithMaxDetailSubscriptionsPerAdmin(n int) Option {
+	return func(s *AdminService) {
+		s.maxDetailPerAdmin = n
+	}
+}
+
+// WithMaxSubscribers는 overview/detail/events 를 합산한 서버 전체 동시 구독 개수를 제한합니다.
+// (기본 MAX_TOTAL_SUBSCRIBERS) 초과 시 ResourceExhausted 로 거부하며, 0 이하이면 제한하지 않습니다.
+func WithMaxSubscribers(n int) Option {
+	return func(s *AdminService) {
+		s.maxSubscribers = n
+	}
+}
+
+// WithLogger는 구조화 로거를 설정합니다. (기본: stderr 텍스트 핸들러)
+// nil 은 무시합니다.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *AdminService) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
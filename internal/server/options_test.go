@@ -0,0 +1,54 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestBufferSizeControlsDrops(t *testing.T) {
+	for _, tt := range []struct {
+		size, wantDropped int
+	}{
+		{size: 1, wantDropped: 4},
+		{size: 8, wantDropped: 0},
+	} {
+		s := newTestService(t, WithBufferSize(tt.size), WithSlowConsumerThreshold(0))
+		startStalledDetail(t, s, "admin-1", "agent-1")
+		for range 5 {
+			s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("frame")})
+		}
+		if got := s.Stats().FramesDropped; got != uint64(tt.wantDropped) {
+			t.Fatalf("버퍼 %d: FramesDropped = %d, want %d", tt.size, got, tt.wantDropped)
+		}
+	}
+}
+
+func TestBufferSizeIgnoresNonPositive(t *testing.T) {
+	if s := newTestService(t, WithBufferSize(0)); s.bufferSize != FRAME_CHANNEL_BUFFER_SIZE {
+		t.Fatalf("WithBufferSize(0) = %d, want 기본값", s.bufferSize)
+	}
+}
+
+func TestLoggerRecordsSubscribeFields(t *testing.T) {
+	logger, logs := newCaptureLogger()
+	s := newTestService(t, WithLogger(logger))
+	stream := newFakeStream[proto.FrameData](t, 1)
+	errCh := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "subscribe 로그", func() bool { return len(logs.withEvent("subscribe")) == 1 })
+	stream.cancel()
+	waitErr(t, errCh)
+
+	for _, event := range []string{"subscribe", "unsubscribe"} {
+		recs := logs.withEvent(event)
+		if len(recs) != 1 {
+			t.Fatalf("%s 로그 %d 개, want 1", event, len(recs))
+		}
+		attrs := recs[0].attrs
+		if attrs["kind"] != "detail" || attrs["adminId"] != "admin-1" || attrs["agentId"] != "agent-1" {
+			t.Fatalf("%s 로그 필드 = %v", event, attrs)
+		}
+	}
+}
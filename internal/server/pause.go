@@ -0,0 +1,75 @@
+// pause.go: 구독 일시정지/재개
+// UI 가 백그라운드로 가는 동안 스트림을 끊지 않고 전달만 멈춰, 병합 큐/채널에 프레임이 쌓이지 않게 합니다.
+// 일시정지 중 도착한 프레임은 보관하지 않고 버리며(heartbeat 는 계속 전송), 재개 시 캐시의 Agent 별 최신 프레임을 한 번 넣어
+// 멈춘 동안의 마지막 화면부터 이어서 보여줍니다. Control 의 paused 또는 구독 요청의 paused 로 제어합니다.
+
+package server
+
+import (
+	"time"
+
+	"admin/proto"
+)
+
+// setPaused는 구독자의 일시정지 상태를 바꾸고, 일시정지에서 재개로 바뀌었으면 true 를 반환합니다.
+// true 이면 호출자가 mu 를 놓은 뒤 resume 으로 최신 프레임을 다시 넣습니다. (재압축이 lock 을 오래 잡지 않도록)
+func (a *adminSubscriber) setPaused(paused bool) bool {
+	return a.paused.Swap(paused) && !paused
+}
+
+// resume은 재개한 구독자에게 캐시된 최신 프레임을 넣습니다.
+// detailAgentId 는 Detail 구독의 대상 Agent 이며 Overview 는 빈 문자열입니다.
+func (s *AdminService) resume(sub *adminSubscriber, detailAgentId string) {
+	if sub.latest != nil {
+		s.resumeOverview(sub)
+		return
+	}
+	s.resumeDetail(sub, detailAgentId)
+}
+
+// resumeOverview는 Overview 구독자에게 허용 Agent 의 캐시된 최신 프레임을 넣습니다. (재압축/포커스 규칙은 broadcast 와 동일)
+func (s *AdminService) resumeOverview(sub *adminSubscriber) {
+	if sub.mosaic {
+		// 모자이크는 다음 합성 주기에 최신 상태로 전달됨
+		return
+	}
+	now := time.Now()
+	for _, entry := range s.lastFrames.entries() {
+		frame := entry.frame
+		if !sub.acceptsAgent(frame.GetAgentId()) {
+			continue
+		}
+		if !sub.focus.is(frame.GetAgentId()) {
+			if s.previewTranscoder != nil {
+				frame = s.previewTranscoder.transcode(frame)
+			}
+			if !sub.acceptsFrame(frame) || !sub.allowSample(frame, now) {
+				continue
+			}
+		}
+		sub.latest.put(frame)
+	}
+}
+
+// resumeDetail은 Detail 구독자에게 대상 Agent(전체 구독이면 모든 Agent)의 캐시된 최신 프레임을 넣습니다.
+// 채널이 가득 차면 나머지는 생략합니다.
+func (s *AdminService) resumeDetail(sub *adminSubscriber, agentId string) {
+	var frames []*proto.FrameData
+	if agentId == WILDCARD_AGENT_ID {
+		for _, entry := range s.lastFrames.entries() {
+			frames = append(frames, entry.frame)
+		}
+	} else if frame, ok := s.lastFrames.load(agentId); ok {
+		frames = append(frames, frame)
+	}
+	for _, frame := range frames {
+		if !sub.acceptsFrame(frame) {
+			continue
+		}
+		sent, _ := sub.enqueueFrame(frame)
+		if !sent {
+			return
+		}
+		s.trackEnqueued(sub, frame)
+	}
+}
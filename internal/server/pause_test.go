@@ -0,0 +1,110 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// pauseControl은 Overview(main) 또는 Detail 구독의 일시정지 상태를 바꿉니다.
+func pauseControl(t *testing.T, s *AdminService, detailAgentId string, paused bool) {
+	t.Helper()
+	req := &proto.ControlRequest{AdminId: "admin-1", DetailAgentId: detailAgentId, Update: &proto.ControlRequest_Paused{Paused: paused}}
+	if detailAgentId == "" {
+		req.SubscriptionId = "main"
+	}
+	if err := s.applyControl(req); err != nil {
+		t.Fatalf("applyControl(paused=%v): %v", paused, err)
+	}
+}
+
+func TestOverviewPauseSuppressesAndResumesWithLatest(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: "main"}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+	push := func(image string) {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte(image), Timestamp: time.Now().UnixMilli()})
+	}
+
+	push("live")
+	if got := stream.next(t); string(got.GetImageData()) != "live" {
+		t.Fatalf("일시정지 전 프레임 = %q, want live", got.GetImageData())
+	}
+
+	pauseControl(t, s, "", true)
+	push("paused-1")
+	push("paused-2")
+	stream.expectNone(t)
+	if sub := overviewSub(s, "admin-1"); sub.latest.depth() != 0 {
+		t.Fatalf("일시정지 중 병합 큐 = %d, want 0 (버퍼링 없음)", sub.latest.depth())
+	}
+
+	// 재개하면 캐시된 최신 프레임 1 개부터 다시 흐름
+	pauseControl(t, s, "", false)
+	if got := stream.next(t); string(got.GetImageData()) != "paused-2" {
+		t.Fatalf("재개 첫 프레임 = %q, want paused-2", got.GetImageData())
+	}
+	stream.expectNone(t)
+	push("resumed")
+	if got := stream.next(t); string(got.GetImageData()) != "resumed" {
+		t.Fatalf("재개 후 프레임 = %q, want resumed", got.GetImageData())
+	}
+}
+
+func TestDetailPauseResume(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	pauseControl(t, s, "agent-1", true)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("a"), Timestamp: time.Now().UnixMilli()})
+	stream.expectNone(t)
+
+	pauseControl(t, s, "agent-1", false)
+	if got := stream.next(t); string(got.GetImageData()) != "a" {
+		t.Fatalf("재개 첫 프레임 = %q, want a", got.GetImageData())
+	}
+}
+
+func TestOverviewSubscribeStartsPaused(t *testing.T) {
+	s := newTestService(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("cached")})
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", SubscriptionId: "main", Paused: true}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+	stream.expectNone(t)
+
+	pauseControl(t, s, "", false)
+	if got := stream.next(t); string(got.GetImageData()) != "cached" {
+		t.Fatalf("재개 첫 프레임 = %q, want cached", got.GetImageData())
+	}
+}
+
+func TestPauseUpdatesWatcherCount(t *testing.T) {
+	s := newTestService(t)
+	changes := watchChanges(s)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 1})
+
+	// 일시정지된 구독은 시청자로 세지 않고, 재개하면 다시 셈
+	pauseControl(t, s, "agent-1", true)
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 0})
+	if got := s.WatcherCount("agent-1"); got != 0 {
+		t.Fatalf("일시정지 중 WatcherCount = %d, want 0", got)
+	}
+	pauseControl(t, s, "agent-1", false)
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 1})
+	expectNoChange(t, changes)
+}
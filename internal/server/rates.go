@@ -0,0 +1,82 @@
+// rates.go: Agent 별 프레임 수신 속도 추정
+// 프레임 도착 간격의 지수 이동 평균(EWMA)으로 초당 프레임 수를 추정합니다.
+// 용량 산정과 과도하게 프레임을 보내는 Agent 탐지에 사용합니다.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 도착 간격 EWMA 가중치 (클수록 최근 간격을 더 크게 반영)
+	FRAME_RATE_EWMA_ALPHA = 0.2
+)
+
+// agentRate는 Agent 하나의 마지막 도착 시각과 평균 도착 간격(초)입니다.
+type agentRate struct {
+	last     time.Time
+	interval float64
+}
+
+// frameRates는 Agent 별 도착 간격 EWMA 를 보관합니다. (짧은 임계 구역의 단일 mutex)
+type frameRates struct {
+	mu      sync.Mutex
+	byAgent map[string]*agentRate
+}
+
+// newFrameRates는 frameRates를 생성합니다.
+func newFrameRates() *frameRates {
+	return &frameRates{byAgent: make(map[string]*agentRate)}
+}
+
+// observe는 프레임 도착을 기록하고 평균 도착 간격을 갱신합니다.
+func (r *frameRates) observe(agentId string, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rate, ok := r.byAgent[agentId]
+	if !ok {
+		r.byAgent[agentId] = &agentRate{last: now}
+		return
+	}
+	gap := now.Sub(rate.last).Seconds()
+	rate.last = now
+	if rate.interval == 0 {
+		rate.interval = gap
+		return
+	}
+	rate.interval += FRAME_RATE_EWMA_ALPHA * (gap - rate.interval)
+}
+
+// snapshot은 Agent 별 추정 초당 프레임 수를 반환합니다.
+// 마지막 프레임 이후 평균 간격보다 오래 지났으면 그 경과 시간을 간격으로 보아 멈춘 Agent 의 값이 내려가게 합니다.
+func (r *frameRates) snapshot(now time.Time) map[string]float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rates := make(map[string]float64, len(r.byAgent))
+	for agentId, rate := range r.byAgent {
+		interval := max(rate.interval, now.Sub(rate.last).Seconds())
+		if interval <= 0 {
+			rates[agentId] = 0
+			continue
+		}
+		rates[agentId] = 1 / interval
+	}
+	return rates
+}
+
+// recordFrameRate는 상태 신호가 아닌 실제 프레임의 도착을 기록합니다.
+func (s *AdminService) recordFrameRate(frame *proto.FrameData) {
+	if isSignalFrame(frame) {
+		return
+	}
+	s.rates.observe(frame.GetAgentId(), time.Now())
+}
+
+// AgentRates는 Agent 별 추정 초당 프레임 수(EWMA)를 반환합니다.
+func (s *AdminService) AgentRates() map[string]float64 {
+	return s.rates.snapshot(time.Now())
+}
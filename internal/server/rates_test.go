@@ -0,0 +1,46 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestFrameRatesEstimateKnownCadence(t *testing.T) {
+	r := newFrameRates()
+	start := time.Now()
+	// 100ms 간격 = 10fps
+	var now time.Time
+	for i := range 50 {
+		now = start.Add(time.Duration(i) * 100 * time.Millisecond)
+		r.observe("agent-1", now)
+	}
+	if got := r.snapshot(now)["agent-1"]; got < 9.5 || got > 10.5 {
+		t.Fatalf("10fps 추정값 = %f", got)
+	}
+	// 멈춘 Agent 는 경과 시간만큼 값이 내려감
+	if got := r.snapshot(now.Add(2 * time.Second))["agent-1"]; got > 0.5 {
+		t.Fatalf("2초 멈춘 Agent 추정값 = %f, want <= 0.5", got)
+	}
+}
+
+func TestAgentRatesFromIncomingFrames(t *testing.T) {
+	s := newTestService(t)
+	const interval = 20 * time.Millisecond
+	for range 20 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+		time.Sleep(interval)
+	}
+	// 상태 신호는 속도 계산에서 제외
+	s.PublishAgentOffline("agent-2")
+
+	rates := s.AgentRates()
+	// 50fps 목표, sleep 지연/경쟁 검사 오버헤드를 감안한 범위
+	if got := rates["agent-1"]; got < 10 || got > 55 {
+		t.Fatalf("agent-1 추정값 = %f, want 약 50fps", got)
+	}
+	if _, ok := rates["agent-2"]; ok {
+		t.Fatal("오프라인 신호만 보낸 Agent 의 속도가 기록됨")
+	}
+}
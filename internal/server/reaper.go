@@ -0,0 +1,55 @@
+// reaper.go: 마지막 수신 이후 조용한 Agent 자동 오프라인 처리
+// 정상 종료 없이 죽은 Agent 는 PublishAgentOffline 이 호출되지 않아 타일이 멈춘 채 남으므로,
+// 설정된 시간 동안 프레임이 없으면 서버가 대신 오프라인 신호를 한 번 보냅니다.
+// 오프라인 신호도 캐시에 저장되므로 다음 프레임이 올 때까지 같은 Agent 를 다시 처리하지 않습니다.
+
+package server
+
+import (
+	"time"
+)
+
+const (
+	// 유휴 Agent 검사 최소 주기
+	AGENT_REAPER_MIN_INTERVAL = 100 * time.Millisecond
+)
+
+// WithAgentIdleTimeout은 마지막 프레임 이후 timeout 이 지나면 해당 Agent 를 자동으로 오프라인 처리하도록 설정합니다.
+// 0 이하이면 자동 처리하지 않습니다. (기본값) 검사는 NewAdminService 에서 시작되어 Shutdown 시 멈춥니다.
+func WithAgentIdleTimeout(timeout time.Duration) Option {
+	return func(s *AdminService) {
+		s.agentIdleTimeout = timeout
+	}
+}
+
+// idleAgents는 마지막 수신 후 timeout 이 지났고 아직 오프라인 신호가 아닌 Agent 목록을 반환합니다.
+func (c *frameCache) idleAgents(now time.Time, timeout time.Duration) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var idle []string
+	for agentId, entry := range c.frames {
+		if isOfflineFrame(entry.frame) || now.Sub(entry.seenAt) < timeout {
+			continue
+		}
+		idle = append(idle, agentId)
+	}
+	return idle
+}
+
+// reapLoop는 주기적으로 유휴 Agent 를 찾아 오프라인 신호를 보냅니다. 서비스 context 취소 시 종료합니다.
+func (s *AdminService) reapLoop() {
+	interval := max(s.agentIdleTimeout/2, AGENT_REAPER_MIN_INTERVAL)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, agentId := range s.lastFrames.idleAgents(now, s.agentIdleTimeout) {
+				s.logger.Info("유휴 Agent 자동 오프라인", "event", "agent_idle_offline", "agentId", agentId, "timeout", s.agentIdleTimeout)
+				s.PublishAgentOffline(agentId)
+			}
+		}
+	}
+}
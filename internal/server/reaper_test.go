@@ -0,0 +1,51 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestReaperPublishesOfflineOnce(t *testing.T) {
+	logger, logs := newCaptureLogger()
+	s := newTestService(t, WithLogger(logger), WithAgentIdleTimeout(100*time.Millisecond))
+	stream := newFakeStream[proto.FrameData](t, 8)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	stream.next(t)
+
+	if got := stream.next(t); !isOfflineFrame(got) {
+		t.Fatalf("유휴 후 수신 프레임 = %v, want offline", got)
+	}
+	// 오프라인 처리된 Agent 는 다음 검사 주기에도 다시 보내지 않음
+	time.Sleep(3 * AGENT_REAPER_MIN_INTERVAL)
+	stream.expectNone(t)
+	if got := len(logs.withEvent("agent_idle_offline")); got != 1 {
+		t.Fatalf("agent_idle_offline 로그 = %d, want 1", got)
+	}
+
+	// 새 프레임이 오면 다시 감시 대상
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("back"), Timestamp: time.Now().UnixMilli()})
+	if got := stream.next(t); string(got.GetImageData()) != "back" {
+		t.Fatalf("복귀 프레임 = %v", got)
+	}
+	if got := stream.next(t); !isOfflineFrame(got) {
+		t.Fatalf("복귀 후 유휴 프레임 = %v, want offline", got)
+	}
+}
+
+func TestReaperDisabledByDefault(t *testing.T) {
+	s := newTestService(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	if idle := s.lastFrames.idleAgents(time.Now().Add(time.Hour), time.Minute); len(idle) != 1 {
+		t.Fatalf("idleAgents = %v, want [agent-1]", idle)
+	}
+	time.Sleep(3 * AGENT_REAPER_MIN_INTERVAL)
+	if frame, _ := s.lastFrames.load("agent-1"); isOfflineFrame(frame) {
+		t.Fatal("유휴 제한 시간 미설정인데 자동 오프라인 처리됨")
+	}
+}
@@ -0,0 +1,46 @@
+// replay.go: Agent 별 최근 이벤트 리플레이 버퍼
+// 늦게 구독한 Admin 도 직전 이벤트를 볼 수 있도록 Agent 별로 최근 이벤트를 고정 크기 링 버퍼에 보관합니다.
+
+package server
+
+import (
+	"sync"
+
+	"admin/proto"
+)
+
+// eventReplay는 Agent 별 최근 이벤트 링 버퍼입니다.
+type eventReplay struct {
+	mu     sync.Mutex
+	size   int
+	events map[string][]*proto.EventData
+}
+
+// newEventReplay는 Agent 당 size 개까지 보관하는 eventReplay를 생성합니다.
+func newEventReplay(size int) *eventReplay {
+	return &eventReplay{size: size, events: make(map[string][]*proto.EventData)}
+}
+
+// append는 이벤트를 추가하고, 크기를 넘으면 가장 오래된 이벤트를 버립니다.
+func (r *eventReplay) append(agentId string, event *proto.EventData) {
+	if r.size <= 0 {
+		return
+	}
+	r.mu.Lock()
+	buf := append(r.events[agentId], event)
+	if len(buf) > r.size {
+		buf = buf[len(buf)-r.size:]
+	}
+	r.events[agentId] = buf
+	r.mu.Unlock()
+}
+
+// snapshot은 Agent 의 보관 이벤트를 오래된 순서로 복사해 반환합니다.
+func (r *eventReplay) snapshot(agentId string) []*proto.EventData {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	buf := r.events[agentId]
+	out := make([]*proto.EventData, len(buf))
+	copy(out, buf)
+	return out
+}
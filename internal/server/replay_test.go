@@ -0,0 +1,34 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestEventReplayDeliveredInOrder(t *testing.T) {
+	s := newTestService(t, WithEventReplaySize(3))
+	for i := range 5 {
+		s.broadcastEvents("agent-1", &proto.EventData{AgentId: "agent-1", EventType: "usb", EventDetail: fmt.Sprint(i)})
+	}
+	s.broadcastEvents("agent-2", &proto.EventData{AgentId: "agent-2", EventType: "usb", EventDetail: "other"})
+
+	stream := newFakeStream[proto.EventData](t, 8)
+	serve(func() error {
+		return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	// 버퍼 크기(3)만큼 가장 최근 이벤트가 오래된 순서로 전달
+	for _, want := range []string{"2", "3", "4"} {
+		if got := stream.next(t).GetEventDetail(); got != want {
+			t.Fatalf("리플레이 이벤트 = %q, want %q", got, want)
+		}
+	}
+	stream.expectNone(t)
+
+	// 리플레이 이후 실시간 이벤트가 이어짐
+	s.broadcastEvents("agent-1", &proto.EventData{AgentId: "agent-1", EventType: "usb", EventDetail: "live"})
+	if got := stream.next(t).GetEventDetail(); got != "live" {
+		t.Fatalf("실시간 이벤트 = %q, want live", got)
+	}
+}
@@ -0,0 +1,48 @@
+// sequence.go: Agent 별 프레임 순번
+// 타임스탬프는 Agent 시계와 해상도에 따라 달라 누락 판단에 쓸 수 없으므로, 필터를 통과한 프레임마다
+// 서버가 Agent 별로 1 씩 증가하는 Sequence 를 붙입니다. 클라이언트는 Detail 스트림에서 번호가 건너뛰면 누락으로 봅니다.
+// (Overview 는 병합/중복 제거로 번호가 원래 건너뜁니다.)
+// 번호는 서버 수명 동안 유지되어 클라이언트 재연결과 무관하게 이어지고, 서버 재시작 시 1 부터 다시 시작합니다.
+// 상태 신호 프레임에는 번호를 붙이지 않습니다. (0)
+
+package server
+
+import (
+	"sync"
+
+	"admin/proto"
+)
+
+// WithFrameSequence는 프레임 순번 부여 여부를 설정합니다. (기본 활성)
+func WithFrameSequence(enabled bool) Option {
+	return func(s *AdminService) {
+		s.sequenceFrames = enabled
+	}
+}
+
+// frameSequencer는 Agent 별 마지막 부여 번호입니다.
+type frameSequencer struct {
+	mu   sync.Mutex
+	last map[string]uint64
+}
+
+// newFrameSequencer는 frameSequencer를 생성합니다.
+func newFrameSequencer() *frameSequencer {
+	return &frameSequencer{last: make(map[string]uint64)}
+}
+
+// next는 Agent 의 다음 번호를 반환합니다.
+func (q *frameSequencer) next(agentId string) uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.last[agentId]++
+	return q.last[agentId]
+}
+
+// assignSequence는 상태 신호가 아닌 프레임에 Agent 별 순번을 붙입니다.
+func (s *AdminService) assignSequence(frame *proto.FrameData) {
+	if !s.sequenceFrames || isSignalFrame(frame) {
+		return
+	}
+	frame.Sequence = s.sequencer.next(frame.GetAgentId())
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+func TestSequencePerAgent(t *testing.T) {
+	s := newTestService(t)
+	ingest := func(agentId string) uint64 {
+		frame := &proto.FrameData{AgentId: agentId, ImageData: []byte("img")}
+		s.HandleIncomingFrame(frame)
+		return frame.GetSequence()
+	}
+
+	for want := uint64(1); want <= 3; want++ {
+		if got := ingest("agent-1"); got != want {
+			t.Fatalf("agent-1 Sequence = %d, want %d", got, want)
+		}
+	}
+	// 다른 Agent 의 번호는 독립적으로 1 부터 시작
+	if got := ingest("agent-2"); got != 1 {
+		t.Fatalf("agent-2 Sequence = %d, want 1", got)
+	}
+	if got := ingest("agent-1"); got != 4 {
+		t.Fatalf("agent-1 Sequence = %d, want 4", got)
+	}
+}
+
+func TestSequenceSkipsSignalFrames(t *testing.T) {
+	s := newTestService(t)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	offline := &proto.FrameData{AgentId: "agent-1", Offline: true}
+	s.HandleIncomingFrame(offline)
+	if offline.GetSequence() != 0 {
+		t.Fatalf("상태 신호 Sequence = %d, want 0", offline.GetSequence())
+	}
+	next := &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")}
+	s.HandleIncomingFrame(next)
+	if next.GetSequence() != 2 {
+		t.Fatalf("상태 신호 이후 Sequence = %d, want 2", next.GetSequence())
+	}
+}
+
+func TestSequenceDisabled(t *testing.T) {
+	s := newTestService(t, WithFrameSequence(false))
+	frame := &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")}
+	s.HandleIncomingFrame(frame)
+	if frame.GetSequence() != 0 {
+		t.Fatalf("비활성 Sequence = %d, want 0", frame.GetSequence())
+	}
+}
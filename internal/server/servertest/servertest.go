@@ -0,0 +1,81 @@
+// servertest.go: 네트워크 없이 AdminService 를 띄우는 인프로세스 테스트 하니스
+// bufconn 위에 gRPC 서버를 올리고, 같은 리스너로 연결하는 dialer/클라이언트를 제공합니다.
+// App(클라이언트)은 NewApp(WithDialer(h.Dialer())) 로 실제 localhost:50051 대신 이 서버에 연결합니다.
+
+package servertest
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"admin/internal/server"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// bufconn 내부 버퍼 크기
+	BUFCONN_SIZE = 1 << 20
+	// bufconn 은 주소를 쓰지 않지만 grpc.NewClient 에 넘길 형식상 주소
+	BUFCONN_ADDRESS = "passthrough:///bufnet"
+	// Close 시 구독 핸들러 종료를 기다리는 최대 시간
+	SHUTDOWN_TIMEOUT = 2 * time.Second
+)
+
+// Harness는 bufconn 위에서 동작하는 AdminService 서버입니다.
+type Harness struct {
+	Service  *server.AdminService
+	server   *grpc.Server
+	listener *bufconn.Listener
+}
+
+// Start는 svc 를 bufconn 리스너에 등록해 서비스를 시작합니다. svc 가 nil 이면 기본 옵션으로 생성합니다.
+// grpcOpts 는 svc.ServerOptions() 뒤에 추가됩니다. (인증 인터셉터 등)
+func Start(svc *server.AdminService, grpcOpts ...grpc.ServerOption) *Harness {
+	if svc == nil {
+		svc = server.NewAdminService()
+	}
+	h := &Harness{
+		Service:  svc,
+		server:   grpc.NewServer(append(svc.ServerOptions(), grpcOpts...)...),
+		listener: bufconn.Listen(BUFCONN_SIZE),
+	}
+	proto.RegisterAdminServiceServer(h.server, svc)
+	go func() {
+		_ = h.server.Serve(h.listener)
+	}()
+	return h
+}
+
+// Dialer는 grpc.WithContextDialer 에 넘길 dialer 를 반환합니다. addr 는 무시합니다.
+func (h *Harness) Dialer() func(context.Context, string) (net.Conn, error) {
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		return h.listener.DialContext(ctx)
+	}
+}
+
+// Client는 하니스 서버에 연결된 AdminService 클라이언트와 연결을 반환합니다. 사용 후 연결을 닫아야 합니다.
+func (h *Harness) Client() (proto.AdminServiceClient, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(BUFCONN_ADDRESS,
+		grpc.WithContextDialer(h.Dialer()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proto.NewAdminServiceClient(conn), conn, nil
+}
+
+// Close는 서비스를 종료하고 gRPC 서버와 리스너를 정리합니다.
+func (h *Harness) Close() {
+	// 전송이 막힌 핸들러가 있어도 멈추지 않도록 제한 시간 후 gRPC 서버를 강제 종료
+	ctx, cancel := context.WithTimeout(context.Background(), SHUTDOWN_TIMEOUT)
+	defer cancel()
+	_ = h.Service.Shutdown(ctx)
+	h.server.Stop()
+	_ = h.listener.Close()
+}
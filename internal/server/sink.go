@@ -0,0 +1,114 @@
+// sink.go: 서버 측 프레임 기록 (FrameSink)
+// Admin 접속 여부와 무관하게 HandleIncomingFrame 을 통과한(필터 적용 후) 모든 프레임을 감사용으로 보관합니다.
+// 싱크는 느릴 수 있으므로 브로드캐스트 경로를 막지 않도록 버퍼 채널 + 단일 워커로 전달하고,
+// 버퍼가 가득 차면 해당 프레임은 싱크에 전달하지 않고 카운터만 올립니다.
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"admin/proto"
+)
+
+const (
+	// 싱크 워커 대기열 크기
+	FRAME_SINK_QUEUE_SIZE = 1024
+)
+
+// FrameSink는 수신 프레임을 받아 기록하는 대상입니다.
+// 프레임은 구독자와 공유되므로 수정하면 안 됩니다. 에러는 로그로만 남습니다.
+type FrameSink interface {
+	WriteFrame(frame *proto.FrameData) error
+}
+
+// sinkDispatcher는 등록된 싱크 목록과 전달 대기열입니다.
+type sinkDispatcher struct {
+	mu    sync.RWMutex
+	sinks []FrameSink
+	queue chan *proto.FrameData
+	once  sync.Once
+}
+
+// RegisterSink는 프레임 싱크를 등록합니다. 첫 등록 시 전달 워커를 시작합니다.
+// 대기열은 싱크를 목록에 넣기 전에 만들어 dispatchToSinks 가 항상 준비된 대기열을 보게 합니다.
+func (s *AdminService) RegisterSink(sink FrameSink) {
+	s.sinks.once.Do(func() {
+		s.sinks.queue = make(chan *proto.FrameData, FRAME_SINK_QUEUE_SIZE)
+		go s.runSinks()
+	})
+	s.sinks.mu.Lock()
+	s.sinks.sinks = append(s.sinks.sinks, sink)
+	s.sinks.mu.Unlock()
+}
+
+// dispatchToSinks는 프레임을 싱크 대기열에 넣습니다. 싱크가 없거나 대기열이 가득 차면 기다리지 않습니다.
+func (s *AdminService) dispatchToSinks(frame *proto.FrameData) {
+	s.sinks.mu.RLock()
+	registered := len(s.sinks.sinks) > 0
+	s.sinks.mu.RUnlock()
+	if !registered {
+		return
+	}
+	select {
+	case s.sinks.queue <- frame:
+	default:
+		s.counters.framesSinkDropped.Add(1)
+	}
+}
+
+// runSinks는 서비스가 종료될 때까지 대기열의 프레임을 모든 싱크에 순서대로 전달합니다.
+func (s *AdminService) runSinks() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case frame := <-s.sinks.queue:
+			s.sinks.mu.RLock()
+			sinks := s.sinks.sinks
+			s.sinks.mu.RUnlock()
+			for _, sink := range sinks {
+				if err := sink.WriteFrame(frame); err != nil {
+					s.logger.Warn("싱크 기록 실패", "event", "sink_error", "agentId", frame.GetAgentId(), "error", err)
+				}
+			}
+		}
+	}
+}
+
+// fileSink는 Agent 별 디렉터리에 프레임 이미지를 파일로 저장하는 FrameSink 입니다.
+type fileSink struct {
+	dir string
+}
+
+// NewFileSink는 dir/<agentId>/<timestamp>-<sequence>.<형식 확장자> 로 프레임을 저장하는 FrameSink 를 생성합니다.
+// 같은 밀리초에 도착한 프레임이 서로 덮어쓰지 않도록 순번을 이름에 포함하며, 이미지가 없는 상태 신호 프레임은 저장하지 않습니다.
+func NewFileSink(dir string) FrameSink {
+	return &fileSink{dir: dir}
+}
+
+// WriteFrame은 프레임 이미지를 파일로 저장합니다.
+func (f *fileSink) WriteFrame(frame *proto.FrameData) error {
+	if len(frame.GetImageData()) == 0 {
+		return nil
+	}
+	agentId := frame.GetAgentId()
+	// agentId 로 기준 디렉터리를 벗어나지 않도록 경로 구분자/상위 경로 거부
+	if agentId == "" || agentId == "." || agentId == ".." || strings.ContainsAny(agentId, `/\`) {
+		return fmt.Errorf("invalid agent id %q for file sink", agentId)
+	}
+	dir := filepath.Join(f.dir, agentId)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create sink dir: %w", err)
+	}
+	name := strconv.FormatInt(frame.GetTimestamp(), 10) + "-" + strconv.FormatUint(frame.GetSequence(), 10) + frameFileExt(frame)
+	if err := os.WriteFile(filepath.Join(dir, name), frame.GetImageData(), 0o644); err != nil {
+		return fmt.Errorf("write frame: %w", err)
+	}
+	return nil
+}
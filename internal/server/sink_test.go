@@ -0,0 +1,92 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// captureSink는 받은 프레임을 메모리에 모으는 테스트용 FrameSink 입니다.
+type captureSink struct {
+	mu     sync.Mutex
+	frames []*proto.FrameData
+}
+
+func (c *captureSink) WriteFrame(frame *proto.FrameData) error {
+	c.mu.Lock()
+	c.frames = append(c.frames, frame)
+	c.mu.Unlock()
+	return nil
+}
+
+// images는 받은 프레임의 이미지 문자열 목록을 반환합니다.
+func (c *captureSink) images() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]string, 0, len(c.frames))
+	for _, frame := range c.frames {
+		out = append(out, string(frame.GetImageData()))
+	}
+	return out
+}
+
+func TestSinkSeesEveryIngestedFrame(t *testing.T) {
+	// 필터에서 버린 프레임은 싱크에도 전달되지 않음
+	s := newTestService(t, WithFrameFilters(FrameFilterFunc(func(frame *proto.FrameData) (*proto.FrameData, bool) {
+		return frame, string(frame.GetImageData()) != "blocked"
+	})))
+	sink := &captureSink{}
+	s.RegisterSink(sink)
+
+	const frames = 50
+	var want []string
+	for i := range frames {
+		image := fmt.Sprintf("frame-%d", i)
+		want = append(want, image)
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: fmt.Sprintf("agent-%d", i%3), ImageData: []byte(image), Timestamp: time.Now().UnixMilli()})
+	}
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-0", ImageData: []byte("blocked"), Timestamp: time.Now().UnixMilli()})
+
+	waitUntil(t, "싱크 전달", func() bool { return len(sink.images()) >= frames })
+	got := sink.images()
+	if len(got) != frames {
+		t.Fatalf("싱크 수신 프레임 = %d, want %d", len(got), frames)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("싱크 수신 순서 %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFileSinkWritesPerAgent(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(dir)
+	image := append([]byte{0xFF, 0xD8, 0xFF}, "jpeg"...)
+	if err := sink.WriteFrame(&proto.FrameData{AgentId: "agent-1", ImageData: image, Timestamp: 1000, Sequence: 7}); err != nil {
+		t.Fatalf("WriteFrame 오류 = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "agent-1", "1000-7.jpg"))
+	if err != nil || string(data) != string(image) {
+		t.Fatalf("저장된 파일 = %q, %v", data, err)
+	}
+	if err := sink.WriteFrame(&proto.FrameData{AgentId: "../escape", ImageData: []byte("jpeg")}); err == nil {
+		t.Fatal("경로를 벗어나는 agentId 가 허용됨")
+	}
+}
+
+func TestFileSinkExtensionFollowsFormat(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(dir)
+	if err := sink.WriteFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("png"), Format: proto.ImageFormat_IMAGE_FORMAT_PNG, Timestamp: 1000, Sequence: 1}); err != nil {
+		t.Fatalf("WriteFrame 오류 = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "agent-1", "1000-1.png")); err != nil {
+		t.Fatalf("PNG 프레임 파일: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+// spotlight.go: 전체 Agent 원본 프레임 구독 (spotlight 뷰)
+// SubscribeDetail 을 agentId WILDCARD_AGENT_ID("*") 로 구독하면 모든 Agent 의 원본 프레임을 받습니다.
+// 대역폭이 크므로 Admin 별 Detail 구독 개수 제한에 포함되며, Agent 별 초당 전달 프레임 수를 제한합니다.
+// 제한에 걸린 프레임은 밀린 것이 아니므로 드롭/퇴출 집계에 넣지 않습니다. (상태 신호는 항상 전달)
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 전체 Detail 구독자에게 Agent 별로 전달하는 초당 최대 프레임 수 기본값
+	WILDCARD_DETAIL_MAX_FPS = 5
+)
+
+// WithWildcardDetailMaxFPS는 전체 Detail 구독자에게 Agent 별로 전달하는 초당 최대 프레임 수를 설정합니다.
+// (기본 WILDCARD_DETAIL_MAX_FPS) 0 이하이면 제한하지 않습니다.
+func WithWildcardDetailMaxFPS(fps int) Option {
+	return func(s *AdminService) {
+		s.wildcardDetailMaxFPS = fps
+	}
+}
+
+// wildcardDetailFPS는 전체 Detail 구독의 Control 요청 fps 를 서버 상한 이하로 맞춥니다.
+// 상한이 설정된 경우 0 이하(제한 해제)는 InvalidArgument 로 거부합니다.
+func (s *AdminService) wildcardDetailFPS(fps int) (int, error) {
+	if s.wildcardDetailMaxFPS <= 0 {
+		return fps, nil
+	}
+	if fps <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "maxFps must be between 1 and %d for wildcard detail subscriptions", s.wildcardDetailMaxFPS)
+	}
+	return min(fps, s.wildcardDetailMaxFPS), nil
+}
+
+// frameRateLimiter는 구독자 하나의 Agent 별 마지막 전달 시각입니다.
+// 여러 Agent 의 프레임이 서로 다른 고루틴에서 broadcast 되므로 mutex 로 보호합니다.
+type frameRateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	last     map[string]time.Time
+}
+
+// newFrameRateLimiter는 초당 fps 개로 제한하는 frameRateLimiter를 생성합니다. fps 가 0 이하이면 nil 입니다.
+func newFrameRateLimiter(fps int) *frameRateLimiter {
+	if fps <= 0 {
+		return nil
+	}
+	return &frameRateLimiter{interval: time.Second / time.Duration(fps), last: make(map[string]time.Time)}
+}
+
+// allow는 Agent 의 직전 전달 이후 간격이 지났으면 전달 시각을 갱신하고 true 를 반환합니다.
+func (l *frameRateLimiter) allow(agentId string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if last, ok := l.last[agentId]; ok && now.Sub(last) < l.interval {
+		return false
+	}
+	l.last[agentId] = now
+	return true
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// wildcardSub는 admin-1 의 전체 Detail 구독을 시작하고 등록될 때까지 기다립니다.
+func wildcardSub(t *testing.T, s *AdminService) *fakeStream[proto.FrameData] {
+	t.Helper()
+	stream := newFakeStream[proto.FrameData](t, 16)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: WILDCARD_AGENT_ID}, stream)
+	})
+	waitUntil(t, "전체 Detail 구독 등록", func() bool { return detailSub(s, "admin-1", WILDCARD_AGENT_ID) != nil })
+	return stream
+}
+
+func TestWildcardDetailReceivesAllAgents(t *testing.T) {
+	s := newTestService(t)
+	stream := wildcardSub(t, s)
+
+	for _, agentId := range []string{"agent-1", "agent-2"} {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte("full-" + agentId), Timestamp: time.Now().UnixMilli()})
+	}
+	got := make(map[string]string)
+	for range 2 {
+		frame := stream.next(t)
+		got[frame.GetAgentId()] = string(frame.GetImageData())
+	}
+	for _, agentId := range []string{"agent-1", "agent-2"} {
+		if got[agentId] != "full-"+agentId {
+			t.Fatalf("%s 프레임 = %q, want 원본 이미지", agentId, got[agentId])
+		}
+	}
+}
+
+func TestWildcardDetailDefaultRateLimit(t *testing.T) {
+	s := newTestService(t)
+	stream := wildcardSub(t, s)
+
+	// 기본 WILDCARD_DETAIL_MAX_FPS 에서는 같은 Agent 의 연속 프레임 중 첫 프레임만 전달
+	for i := range 3 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}, Timestamp: time.Now().UnixMilli()})
+	}
+	if frame := stream.next(t); frame.GetImageData()[0] != 0 {
+		t.Fatalf("첫 전달 프레임 = %v, want 0 번째", frame.GetImageData())
+	}
+	stream.expectNone(t)
+
+	// 다른 Agent 는 별도 한도를 가짐
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-2", ImageData: []byte("b"), Timestamp: time.Now().UnixMilli()})
+	if frame := stream.next(t); frame.GetAgentId() != "agent-2" {
+		t.Fatalf("전달 프레임 AgentId = %q, want agent-2", frame.GetAgentId())
+	}
+}
+
+func TestWildcardDetailCountsTowardAdminLimit(t *testing.T) {
+	s := newTestService(t, WithMaxDetailSubscriptionsPerAdmin(1))
+	wildcardSub(t, s)
+
+	err := s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, newFakeStream[proto.FrameData](t, 1))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("한도 초과 구독 오류 = %v, want ResourceExhausted", err)
+	}
+}
+
+func TestWildcardDetailFPSClamp(t *testing.T) {
+	s := newTestService(t)
+	if got, err := s.wildcardDetailFPS(100); err != nil || got != WILDCARD_DETAIL_MAX_FPS {
+		t.Fatalf("wildcardDetailFPS(100) = %d, %v, want %d", got, err, WILDCARD_DETAIL_MAX_FPS)
+	}
+	if got, err := s.wildcardDetailFPS(2); err != nil || got != 2 {
+		t.Fatalf("wildcardDetailFPS(2) = %d, %v, want 2", got, err)
+	}
+	if _, err := s.wildcardDetailFPS(0); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("wildcardDetailFPS(0) 오류 = %v, want InvalidArgument", err)
+	}
+
+	// 상한이 없으면 요청 값 그대로 (0 은 제한 해제)
+	unlimited := newTestService(t, WithWildcardDetailMaxFPS(0))
+	if got, err := unlimited.wildcardDetailFPS(0); err != nil || got != 0 {
+		t.Fatalf("상한 없는 wildcardDetailFPS(0) = %d, %v, want 0", got, err)
+	}
+}
@@ -0,0 +1,116 @@
+// stats.go: AdminService 운영 지표 (구독자 수 / 전송·드롭 카운터 / 구독자별 적체)
+// 구독자 수는 조회 시점에 맵에서 계산하고, 전송/드롭 카운터는 broadcast 경로에서 atomic 으로 누적합니다.
+
+package server
+
+import "sync/atomic"
+
+// serviceCounters는 broadcast 경로에서 누적되는 카운터 묶음입니다.
+type serviceCounters struct {
+	framesBroadcast   atomic.Uint64
+	framesDropped     atomic.Uint64
+	framesCoalesced   atomic.Uint64
+	framesOversize    atomic.Uint64
+	framesSinkDropped atomic.Uint64
+	framesShed        atomic.Uint64
+	eventsBroadcast   atomic.Uint64
+	eventsDropped     atomic.Uint64
+}
+
+// ServiceStats는 Stats()가 반환하는 AdminService 상태 스냅샷입니다.
+type ServiceStats struct {
+	OverviewSubscribers      int            `json:"overviewSubscribers"`
+	DetailSubscribers        int            `json:"detailSubscribers"`
+	DetailSubscribersByAgent map[string]int `json:"detailSubscribersByAgent"`
+	EventSubscribers         int            `json:"eventSubscribers"`
+	ActiveSubscribers        int            `json:"activeSubscribers"`
+	FramesBroadcast          uint64         `json:"framesBroadcast"`
+	FramesDropped            uint64         `json:"framesDropped"`
+	FramesCoalesced          uint64         `json:"framesCoalesced"`
+	FramesOversize           uint64         `json:"framesOversize"`
+	FramesSinkDropped        uint64         `json:"framesSinkDropped"`
+	FramesShed               uint64         `json:"framesShed"`    // 메모리 상한 초과로 버린 프레임
+	BufferedBytes            int64          `json:"bufferedBytes"` // Detail 채널 적재 바이트 합계 (근사치)
+	EventsBroadcast          uint64         `json:"eventsBroadcast"`
+	EventsDropped            uint64         `json:"eventsDropped"`
+}
+
+// Stats는 현재 구독자 수와 누적 전송/드롭 카운터를 반환합니다.
+func (s *AdminService) Stats() ServiceStats {
+	st := ServiceStats{
+		DetailSubscribersByAgent: make(map[string]int),
+		FramesBroadcast:          s.counters.framesBroadcast.Load(),
+		FramesDropped:            s.counters.framesDropped.Load(),
+		FramesCoalesced:          s.counters.framesCoalesced.Load(),
+		FramesOversize:           s.counters.framesOversize.Load(),
+		FramesSinkDropped:        s.counters.framesSinkDropped.Load(),
+		FramesShed:               s.counters.framesShed.Load(),
+		BufferedBytes:            s.bufferedBytes.Load(),
+		EventsBroadcast:          s.counters.eventsBroadcast.Load(),
+		EventsDropped:            s.counters.eventsDropped.Load(),
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	st.OverviewSubscribers = len(s.overviewSubs)
+	for _, subs := range s.detailSubs {
+		for agentId := range subs {
+			st.DetailSubscribers++
+			st.DetailSubscribersByAgent[agentId]++
+		}
+	}
+	for _, subs := range s.eventSubs {
+		st.EventSubscribers += len(subs)
+	}
+	st.ActiveSubscribers = s.activeSubscribers
+	return st
+}
+
+// SubscriberStat은 개별 구독자의 적체 상태입니다. 특정 느린 Admin 연결을 찾는 데 사용합니다.
+type SubscriberStat struct {
+	AdminId        string `json:"adminId"`
+	Kind           string `json:"kind"` // overview | detail | events
+	AgentId        string `json:"agentId,omitempty"`
+	SubscriptionId string `json:"subscriptionId,omitempty"`
+	QueueDepth     int    `json:"queueDepth"`
+	Dropped        uint64 `json:"dropped"` // overview 는 병합으로 버려진 프레임 수
+}
+
+// SubscriberStats는 현재 활성 구독자별 대기 큐 길이와 누적 드롭 수를 반환합니다.
+func (s *AdminService) SubscriberStats() []SubscriberStat {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stats := make([]SubscriberStat, 0, len(s.overviewSubs))
+	for key, sub := range s.overviewSubs {
+		stats = append(stats, SubscriberStat{
+			AdminId:        key.adminId,
+			Kind:           "overview",
+			SubscriptionId: key.subscriptionId,
+			QueueDepth:     sub.latest.depth(),
+			Dropped:        sub.totalDrops.Load(),
+		})
+	}
+	for adminId, subs := range s.detailSubs {
+		for agentId, sub := range subs {
+			stats = append(stats, SubscriberStat{
+				AdminId:    adminId,
+				Kind:       "detail",
+				AgentId:    agentId,
+				QueueDepth: len(sub.frames()),
+				Dropped:    sub.totalDrops.Load(),
+			})
+		}
+	}
+	for adminId, subs := range s.eventSubs {
+		for agentId, sub := range subs {
+			stats = append(stats, SubscriberStat{
+				AdminId:    adminId,
+				Kind:       "events",
+				AgentId:    agentId,
+				QueueDepth: len(sub.eventChan),
+				Dropped:    sub.totalDrops.Load(),
+			})
+		}
+	}
+	return stats
+}
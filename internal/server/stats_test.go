@@ -0,0 +1,81 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+)
+
+// startStalledDetail은 전송이 막힌(읽지 않는) Detail 구독을 시작하고, 첫 프레임이 핸들러에 잡혀 채널이 빈 상태로 만듭니다.
+// 이후 broadcast 는 채널 버퍼만큼 적재되고 나머지는 드롭됩니다.
+func startStalledDetail(t *testing.T, s *AdminService, adminId, agentId string) *adminSubscriber {
+	t.Helper()
+	stream := newFakeStream[proto.FrameData](t, 0)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: adminId, AgentId: agentId}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, adminId, agentId) != nil })
+	sub := detailSub(s, adminId, agentId)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: agentId, ImageData: []byte("first")})
+	waitUntil(t, "첫 프레임 전송 대기", func() bool { return len(sub.frames()) == 0 })
+	return sub
+}
+
+func TestStatsCountsBroadcastAndDrops(t *testing.T) {
+	s := newTestService(t, WithBufferSize(2), WithSlowConsumerThreshold(0))
+	startStalledDetail(t, s, "admin-1", "agent-1")
+	for range 5 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("frame")})
+	}
+
+	st := s.Stats()
+	if st.DetailSubscribers != 1 || st.DetailSubscribersByAgent["agent-1"] != 1 || st.ActiveSubscribers != 1 {
+		t.Fatalf("구독자 수 = %+v, want detail 1", st)
+	}
+	// 첫 프레임 1 + 버퍼 2 적재, 나머지 3 드롭
+	if st.FramesBroadcast != 3 {
+		t.Fatalf("FramesBroadcast = %d, want 3", st.FramesBroadcast)
+	}
+	if st.FramesDropped != 3 {
+		t.Fatalf("FramesDropped = %d, want 3", st.FramesDropped)
+	}
+}
+
+func TestSubscriberStatsAttributesDropsToSlowSubscriber(t *testing.T) {
+	s := newTestService(t, WithBufferSize(1), WithSlowConsumerThreshold(0))
+	startStalledDetail(t, s, "admin-slow", "agent-1")
+	healthy := newFakeStream[proto.FrameData](t, 16)
+	healthy.discard()
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-ok", AgentId: "agent-1"}, healthy)
+	})
+	waitUntil(t, "정상 Detail 구독 등록", func() bool { return detailSub(s, "admin-ok", "agent-1") != nil })
+	ok := detailSub(s, "admin-ok", "agent-1")
+
+	for range 5 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("frame")})
+		// 정상 구독자는 매 프레임을 소비한 뒤 다음 프레임을 받음
+		waitUntil(t, "정상 구독자 소비", func() bool { return len(ok.frames()) == 0 })
+	}
+
+	var slow, fine *SubscriberStat
+	stats := s.SubscriberStats()
+	for i := range stats {
+		switch stats[i].AdminId {
+		case "admin-slow":
+			slow = &stats[i]
+		case "admin-ok":
+			fine = &stats[i]
+		}
+	}
+	if slow == nil || fine == nil {
+		t.Fatalf("SubscriberStats = %+v, want admin-slow 와 admin-ok", stats)
+	}
+	// 버퍼 1 적재, 나머지 4 드롭
+	if slow.Dropped != 4 || slow.QueueDepth != 1 {
+		t.Fatalf("느린 구독자 = %+v, want Dropped 4, QueueDepth 1", *slow)
+	}
+	if fine.Dropped != 0 {
+		t.Fatalf("정상 구독자 Dropped = %d, want 0", fine.Dropped)
+	}
+}
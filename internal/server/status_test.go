@@ -0,0 +1,56 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestFrameStatusClassification(t *testing.T) {
+	cases := []struct {
+		name                     string
+		frame                    *proto.FrameData
+		offline, isError, online bool
+	}{
+		{"live", &proto.FrameData{AgentId: "a", ImageData: []byte("img"), Timestamp: 1000}, false, false, false},
+		{"offline", newOfflineFrame("a"), true, false, false},
+		{"legacy offline", &proto.FrameData{AgentId: "a", ImageData: []byte{}, Timestamp: OFFLINE_TIMESTAMP}, true, false, false},
+		{"offline status only", &proto.FrameData{AgentId: "a", Timestamp: 1000, Status: proto.FrameStatus_FRAME_STATUS_OFFLINE}, true, false, false},
+		{"error", newErrorFrame("a", "capture failed"), false, true, false},
+		{"online", newOnlineFrame("a"), false, false, true},
+	}
+	for _, tc := range cases {
+		if got := isOfflineFrame(tc.frame); got != tc.offline {
+			t.Errorf("%s: isOfflineFrame = %v, want %v", tc.name, got, tc.offline)
+		}
+		if got := isErrorFrame(tc.frame); got != tc.isError {
+			t.Errorf("%s: isErrorFrame = %v, want %v", tc.name, got, tc.isError)
+		}
+		if got := isOnlineFrame(tc.frame); got != tc.online {
+			t.Errorf("%s: isOnlineFrame = %v, want %v", tc.name, got, tc.online)
+		}
+	}
+}
+
+func TestStatusFramesDelivered(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	if got := stream.next(t); got.GetStatus() == proto.FrameStatus_FRAME_STATUS_ERROR || isOfflineFrame(got) {
+		t.Fatalf("live 프레임 상태 = %v", got.GetStatus())
+	}
+	s.PublishAgentError("agent-1", "capture failed")
+	if got := stream.next(t); got.GetStatus() != proto.FrameStatus_FRAME_STATUS_ERROR || got.GetStatusMessage() != "capture failed" || isOfflineFrame(got) {
+		t.Fatalf("error 프레임 = %v", got)
+	}
+	s.PublishAgentOffline("agent-1")
+	if got := stream.next(t); got.GetStatus() != proto.FrameStatus_FRAME_STATUS_OFFLINE || !got.GetOffline() {
+		t.Fatalf("offline 프레임 = %v", got)
+	}
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// 동시성 스트레스 테스트 규모
+const (
+	STRESS_SUBSCRIBERS = 8
+	STRESS_ROUNDS      = 30
+	STRESS_FRAMES      = 300
+)
+
+// TestConcurrentSubscribeBroadcastClose는 구독/broadcast/종료(취소, 퇴출)를 동시에 반복해
+// 닫힌 구독자에게 전송하다 panic 이 나거나 data race 가 생기지 않는지 확인합니다. (go test -race)
+func TestConcurrentSubscribeBroadcastClose(t *testing.T) {
+	s := newTestService(t, WithBufferSize(1), WithSlowConsumerThreshold(2))
+	var wg sync.WaitGroup
+
+	for i := range STRESS_SUBSCRIBERS {
+		adminId := fmt.Sprintf("admin-%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := range STRESS_ROUNDS {
+				overview := newFakeStream[proto.FrameData](t, 0)
+				detail := newFakeStream[proto.FrameData](t, 0)
+				events := newFakeStream[proto.EventData](t, 0)
+				// 절반은 읽어서 정상 소비, 나머지는 읽지 않아 퇴출 경로를 탐
+				if round%2 == 0 {
+					overview.discard()
+					detail.discard()
+					events.discard()
+				}
+				errs := []<-chan error{
+					serve(func() error {
+						return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: adminId}, overview)
+					}),
+					serve(func() error {
+						return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: adminId, AgentId: "agent-1"}, detail)
+					}),
+					serve(func() error {
+						return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: adminId, AgentId: "agent-1"}, events)
+					}),
+				}
+				time.Sleep(time.Millisecond)
+				overview.cancel()
+				detail.cancel()
+				events.cancel()
+				for _, errCh := range errs {
+					<-errCh
+				}
+			}
+		}()
+	}
+
+	stop := make(chan struct{})
+	var producers sync.WaitGroup
+	producers.Add(1)
+	go func() {
+		defer producers.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}, Timestamp: time.Now().UnixMilli()})
+			s.broadcastEvents("agent-1", &proto.EventData{AgentId: "agent-1"})
+			if i%STRESS_FRAMES == 0 {
+				s.PublishAgentOffline("agent-1")
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(stop)
+	producers.Wait()
+
+	waitUntil(t, "모든 구독 정리", func() bool { return s.Stats().ActiveSubscribers == 0 })
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown 오류 = %v", err)
+	}
+}
+
+// TestShutdownDuringSubscribeChurn은 구독/취소를 반복하는 도중 Shutdown 을 호출해
+// 핸들러 정리 defer 와 Shutdown 이 mu 를 두고 교착되지 않는지 확인합니다. (go test -race)
+func TestShutdownDuringSubscribeChurn(t *testing.T) {
+	s := newTestService(t, WithBufferSize(1))
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	for i := range STRESS_SUBSCRIBERS {
+		adminId := fmt.Sprintf("admin-%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := 0; ; round++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				overview := newFakeStream[proto.FrameData](t, 0)
+				detail := newFakeStream[proto.FrameData](t, 0)
+				overview.discard()
+				detail.discard()
+				errs := []<-chan error{
+					serve(func() error {
+						return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: adminId}, overview)
+					}),
+					serve(func() error {
+						return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: adminId, AgentId: "agent-1"}, detail)
+					}),
+				}
+				// 절반은 직접 취소, 나머지는 Shutdown 이 닫을 때까지 유지
+				if round%2 == 0 {
+					time.Sleep(time.Millisecond)
+					overview.cancel()
+					detail.cancel()
+				}
+				for _, errCh := range errs {
+					<-errCh
+				}
+				overview.cancel()
+				detail.cancel()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}, Timestamp: time.Now().UnixMilli()})
+		}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	// 동시에 여러 번 호출해도 모두 핸들러 종료까지 기다린 뒤 반환
+	shutdownErrs := make(chan error, 2)
+	for range 2 {
+		go func() { shutdownErrs <- s.Shutdown(ctx) }()
+	}
+	for range 2 {
+		if err := <-shutdownErrs; err != nil {
+			t.Fatalf("Shutdown 오류 = %v", err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+
+	if got := s.Stats().ActiveSubscribers; got != 0 {
+		t.Fatalf("Shutdown 후 구독자 = %d, want 0", got)
+	}
+}
@@ -0,0 +1,49 @@
+// stride.go: 구독자별 N 번째 프레임 샘플링
+// 성능이 낮은 클라이언트가 서버 측 병합/속도 제한에 기대지 않고 Agent 별 N 번째 프레임마다 1개만 받도록 합니다.
+// broadcast 경로에서 구독자가 받을 후보 프레임을 세며, FPS 제한이 함께 있으면 두 조건을 모두 통과해야 전달합니다.
+// (더 엄격한 쪽이 실제 전달률을 결정) 상태 신호 프레임은 세지 않고 항상 전달합니다.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"admin/proto"
+)
+
+// frameStride는 구독자 하나의 Agent 별 후보 프레임 수입니다.
+type frameStride struct {
+	mu     sync.Mutex
+	n      uint64
+	counts map[string]uint64
+}
+
+// newFrameStride는 n 번째 프레임마다 통과시키는 frameStride를 생성합니다. n 이 1 이하이면 nil 입니다.
+func newFrameStride(n uint32) *frameStride {
+	if n <= 1 {
+		return nil
+	}
+	return &frameStride{n: uint64(n), counts: make(map[string]uint64)}
+}
+
+// allow는 Agent 의 후보 프레임 수를 세고, 첫 프레임과 이후 n 번째마다 true 를 반환합니다.
+func (f *frameStride) allow(agentId string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.counts[agentId]
+	f.counts[agentId] = c + 1
+	return c%f.n == 0
+}
+
+// allowSample은 구독자의 stride 와 전달 속도 제한을 모두 통과하는지 판단합니다. 상태 신호는 항상 통과합니다.
+func (a *adminSubscriber) allowSample(frame *proto.FrameData, now time.Time) bool {
+	if isSignalFrame(frame) {
+		return true
+	}
+	if stride := a.stride.Load(); stride != nil && !stride.allow(frame.GetAgentId()) {
+		return false
+	}
+	limit := a.rateLimit.Load()
+	return limit == nil || limit.allow(frame.GetAgentId(), now)
+}
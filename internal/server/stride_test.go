@@ -0,0 +1,50 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestDetailStrideDeliversEveryNth(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 16)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1", Stride: 3}, stream)
+	})
+	waitUntil(t, "Detail 구독 등록", func() bool { return detailSub(s, "admin-1", "agent-1") != nil })
+
+	for i := range 9 {
+		s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte{byte(i)}})
+	}
+	// 첫 프레임과 이후 3 번째마다 전달: 0, 3, 6
+	for _, want := range []byte{0, 3, 6} {
+		if got := stream.next(t).GetImageData()[0]; got != want {
+			t.Fatalf("전달 프레임 = %d, want %d", got, want)
+		}
+	}
+	stream.expectNone(t)
+}
+
+func TestStrideAndRateLimitMoreRestrictiveWins(t *testing.T) {
+	sub := newAdminSubscriber("admin-1", 1)
+	sub.stride.Store(newFrameStride(2))
+	sub.rateLimit.Store(newFrameRateLimiter(1))
+	frame := &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")}
+	now := time.Now()
+
+	var passed int
+	for range 4 {
+		if sub.allowSample(frame, now) {
+			passed++
+		}
+	}
+	// stride 는 2 개를 통과시키지만 같은 시각에는 속도 제한이 1 개만 허용
+	if passed != 1 {
+		t.Fatalf("통과 프레임 = %d, want 1", passed)
+	}
+	if !sub.allowSample(&proto.FrameData{AgentId: "agent-1", Offline: true}, now) {
+		t.Fatal("상태 신호가 샘플링에 걸림")
+	}
+}
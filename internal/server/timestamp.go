@@ -0,0 +1,48 @@
+// timestamp.go: Agent 프레임 타임스탬프 검증
+// Agent 시계가 틀어져 먼 미래/과거 타임스탬프(Unix ms)를 보내면 정렬/정리 로직이 오동작하므로,
+// 허용 오차를 벗어난 값은 서버 수신 시각으로 보정하고 오차를 로그로 남깁니다.
+// 오프라인/온라인/heartbeat 등 상태 신호 프레임은 특수 타임스탬프를 쓰므로 검사하지 않습니다.
+
+package server
+
+import (
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 기본 허용 시계 오차
+	FRAME_CLOCK_SKEW_TOLERANCE = 5 * time.Minute
+)
+
+// WithMaxClockSkew는 Agent 타임스탬프 허용 오차를 설정합니다. (기본 FRAME_CLOCK_SKEW_TOLERANCE)
+// 0 이하이면 보정하지 않습니다.
+func WithMaxClockSkew(d time.Duration) Option {
+	return func(s *AdminService) {
+		s.maxClockSkew = d
+	}
+}
+
+// isSignalFrame는 이미지 없는 상태 신호 프레임(오프라인/온라인/오류/heartbeat)인지 판단합니다.
+func isSignalFrame(frame *proto.FrameData) bool {
+	if isOfflineFrame(frame) || isOnlineFrame(frame) || isErrorFrame(frame) {
+		return true
+	}
+	return frame.GetTimestamp() == HEARTBEAT_TIMESTAMP && len(frame.GetImageData()) == 0
+}
+
+// normalizeTimestamp는 허용 오차를 벗어난 프레임 타임스탬프를 서버 시각으로 보정합니다.
+// HandleIncomingFrame 이 프레임 소유권을 가지므로 제자리에서 수정합니다.
+func (s *AdminService) normalizeTimestamp(frame *proto.FrameData) {
+	if s.maxClockSkew <= 0 || isSignalFrame(frame) {
+		return
+	}
+	now := time.Now().UnixMilli()
+	skew := time.Duration(frame.GetTimestamp()-now) * time.Millisecond
+	if skew >= -s.maxClockSkew && skew <= s.maxClockSkew {
+		return
+	}
+	s.logger.Warn("타임스탬프 오차 보정", "event", "clock_skew", "agentId", frame.GetAgentId(), "timestamp", frame.GetTimestamp(), "skew", skew)
+	frame.Timestamp = now
+}
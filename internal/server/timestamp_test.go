@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestTimestampZeroImageFrameIsNotOffline(t *testing.T) {
+	s := newTestService(t)
+	before := time.Now().UnixMilli()
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: 0})
+
+	frame, ok := s.lastFrames.load("agent-1")
+	if !ok {
+		t.Fatal("타임스탬프 0 프레임이 캐시되지 않음")
+	}
+	if isOfflineFrame(frame) {
+		t.Fatal("이미지가 있는 타임스탬프 0 프레임이 오프라인으로 판정됨")
+	}
+	// 0 은 허용 오차를 벗어나므로 수신 시각으로 보정
+	if frame.GetTimestamp() < before {
+		t.Fatalf("Timestamp = %d, want >= %d", frame.GetTimestamp(), before)
+	}
+	agents := s.ListActiveAgents()
+	if len(agents) != 1 || agents[0].Offline || !agents[0].Online {
+		t.Fatalf("ListActiveAgents = %+v, want online agent-1", agents)
+	}
+}
+
+func TestLegacyOfflineSignalStillHonored(t *testing.T) {
+	legacy := &proto.FrameData{AgentId: "agent-1", ImageData: []byte{}, Timestamp: OFFLINE_TIMESTAMP}
+	if !isOfflineFrame(legacy) {
+		t.Fatal("구버전 오프라인 신호(빈 이미지 + 타임스탬프 0)를 인정하지 않음")
+	}
+	if !isOfflineFrame(newOfflineFrame("agent-1")) {
+		t.Fatal("Offline 플래그 프레임을 인정하지 않음")
+	}
+}
+
+func TestSkewedTimestampNormalized(t *testing.T) {
+	logger, logs := newCaptureLogger()
+	s := newTestService(t, WithLogger(logger))
+	future := time.Now().Add(time.Hour).UnixMilli()
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: future})
+
+	frame, _ := s.lastFrames.load("agent-1")
+	if frame.GetTimestamp() >= future || frame.GetTimestamp() > time.Now().UnixMilli() {
+		t.Fatalf("Timestamp = %d, want 서버 시각으로 보정", frame.GetTimestamp())
+	}
+	if len(logs.withEvent("clock_skew")) != 1 {
+		t.Fatal("clock_skew 로그가 남지 않음")
+	}
+
+	// 허용 오차 이내는 그대로 유지
+	near := time.Now().Add(time.Minute).UnixMilli()
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img2"), Timestamp: near})
+	frame, _ = s.lastFrames.load("agent-1")
+	if frame.GetTimestamp() != near {
+		t.Fatalf("허용 오차 이내 Timestamp = %d, want %d", frame.GetTimestamp(), near)
+	}
+}
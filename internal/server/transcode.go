@@ -0,0 +1,115 @@
+// transcode.go: Overview 용 미리보기 재압축
+// Overview 타일은 작은 썸네일이므로 원본을 축소/저화질 JPEG 로 다시 인코딩해 전송량을 줄입니다.
+// Detail 구독자는 항상 원본을 받으며, 디코딩 실패나 결과가 더 큰 경우 원본을 그대로 전달합니다.
+// 해당 Agent 의 미리보기를 받을 Overview 구독자가 없으면 재압축하지 않습니다.
+
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+
+	"admin/proto"
+
+	gproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	// 미리보기 기본 최대 크기 및 JPEG 품질
+	PREVIEW_MAX_WIDTH    = 480
+	PREVIEW_MAX_HEIGHT   = 270
+	PREVIEW_JPEG_QUALITY = 60
+)
+
+// previewTranscoder는 미리보기 재압축 설정입니다.
+type previewTranscoder struct {
+	maxWidth  int
+	maxHeight int
+	quality   int
+}
+
+// WithPreviewTranscode는 Overview 전송 전 미리보기 재압축을 활성화합니다.
+// 0 이하 값은 기본값(PREVIEW_MAX_WIDTH/HEIGHT, PREVIEW_JPEG_QUALITY)을 사용합니다.
+func WithPreviewTranscode(maxWidth, maxHeight, quality int) Option {
+	return func(s *AdminService) {
+		if maxWidth <= 0 {
+			maxWidth = PREVIEW_MAX_WIDTH
+		}
+		if maxHeight <= 0 {
+			maxHeight = PREVIEW_MAX_HEIGHT
+		}
+		if quality <= 0 || quality > 100 {
+			quality = PREVIEW_JPEG_QUALITY
+		}
+		s.previewTranscoder = &previewTranscoder{maxWidth: maxWidth, maxHeight: maxHeight, quality: quality}
+	}
+}
+
+// overviewWantsPreview는 agentId 의 미리보기를 받을 Overview 구독자가 있는지 반환합니다.
+// 모자이크·일시정지 구독, Agent 필터에서 제외된 구독, 해당 Agent 를 포커스해 원본을 받는 구독은 제외합니다.
+func (s *AdminService) overviewWantsPreview(agentId string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, sub := range s.overviewSubs {
+		if !sub.mosaic && !sub.paused.Load() && sub.acceptsAgent(agentId) && !sub.focus.is(agentId) {
+			return true
+		}
+	}
+	return false
+}
+
+// transcode는 프레임의 미리보기 사본을 생성합니다. 원본 프레임은 수정하지 않습니다.
+// 상태 신호(빈 이미지)·판별 불가 형식 프레임이거나 재압축이 불가능/무의미하면 원본을 그대로 반환합니다.
+func (t *previewTranscoder) transcode(frame *proto.FrameData) *proto.FrameData {
+	// 디코더가 등록된 형식(JPEG/PNG)만 재압축
+	switch frame.GetFormat() {
+	case proto.ImageFormat_IMAGE_FORMAT_JPEG, proto.ImageFormat_IMAGE_FORMAT_PNG:
+	default:
+		return frame
+	}
+	src, _, err := image.Decode(bytes.NewReader(frame.GetImageData()))
+	if err != nil {
+		return frame
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, t.resize(src), &jpeg.Options{Quality: t.quality}); err != nil {
+		return frame
+	}
+	if buf.Len() >= len(frame.GetImageData()) {
+		return frame
+	}
+	preview := gproto.Clone(frame).(*proto.FrameData)
+	preview.ImageData = buf.Bytes()
+	preview.IsPreview = true
+	preview.Format = proto.ImageFormat_IMAGE_FORMAT_JPEG
+	return preview
+}
+
+// resize는 종횡비를 유지하며 최대 크기 안으로 축소합니다 (nearest-neighbor).
+func (t *previewTranscoder) resize(src image.Image) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= t.maxWidth && h <= t.maxHeight {
+		return src
+	}
+	dw, dh := t.maxWidth, h*t.maxWidth/w
+	if dh > t.maxHeight {
+		dw, dh = w*t.maxHeight/h, t.maxHeight
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy := b.Min.Y + y*h/dh
+		for x := 0; x < dw; x++ {
+			dst.Set(x, y, src.At(b.Min.X+x*w/dw, sy))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"admin/proto"
+)
+
+// testJPEG는 w×h 크기의 고화질 JPEG 이미지를 생성합니다.
+func testJPEG(tb testing.TB, w, h int) []byte {
+	tb.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, color.RGBA{R: uint8(x * 7), G: uint8(y * 5), B: uint8(x ^ y), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		tb.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestPreviewTranscodeShrinksOverviewOnly(t *testing.T) {
+	s := newTestService(t, WithPreviewTranscode(0, 0, 0))
+	overview := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, overview)
+	})
+	detail := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, detail)
+	})
+	waitUntil(t, "구독 등록", func() bool { return s.Stats().ActiveSubscribers == 2 })
+
+	original := testJPEG(t, 1280, 720)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: original})
+
+	preview := overview.next(t)
+	if !preview.GetIsPreview() || len(preview.GetImageData()) >= len(original) {
+		t.Fatalf("Overview 프레임 %d bytes (preview=%v), want 원본 %d bytes 보다 작은 미리보기", len(preview.GetImageData()), preview.GetIsPreview(), len(original))
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(preview.GetImageData()))
+	if err != nil {
+		t.Fatalf("미리보기 디코딩 실패: %v", err)
+	}
+	if cfg.Width > PREVIEW_MAX_WIDTH || cfg.Height > PREVIEW_MAX_HEIGHT {
+		t.Fatalf("미리보기 크기 %dx%d, want 최대 %dx%d", cfg.Width, cfg.Height, PREVIEW_MAX_WIDTH, PREVIEW_MAX_HEIGHT)
+	}
+	if got := detail.next(t); !bytes.Equal(got.GetImageData(), original) || got.GetIsPreview() {
+		t.Fatal("Detail 구독자가 원본을 받지 못함")
+	}
+}
+
+func TestPreviewTranscodeKeepsUndecodable(t *testing.T) {
+	tr := &previewTranscoder{maxWidth: PREVIEW_MAX_WIDTH, maxHeight: PREVIEW_MAX_HEIGHT, quality: PREVIEW_JPEG_QUALITY}
+	frame := &proto.FrameData{AgentId: "agent-1", ImageData: []byte("not an image"), Format: proto.ImageFormat_IMAGE_FORMAT_JPEG}
+	if got := tr.transcode(frame); got != frame {
+		t.Fatal("디코딩할 수 없는 프레임이 변경됨")
+	}
+}
+
+func BenchmarkPreviewTranscode(b *testing.B) {
+	tr := &previewTranscoder{maxWidth: PREVIEW_MAX_WIDTH, maxHeight: PREVIEW_MAX_HEIGHT, quality: PREVIEW_JPEG_QUALITY}
+	frame := &proto.FrameData{AgentId: "agent-1", ImageData: testJPEG(b, 1920, 1080), Format: proto.ImageFormat_IMAGE_FORMAT_JPEG}
+	b.SetBytes(int64(len(frame.GetImageData())))
+	b.ResetTimer()
+	for range b.N {
+		tr.transcode(frame)
+	}
+}
+
+func TestOverviewWantsPreview(t *testing.T) {
+	s := newTestService(t, WithPreviewTranscode(0, 0, 0))
+	if s.overviewWantsPreview("agent-1") {
+		t.Fatal("Overview 구독자가 없는데 미리보기 필요로 판단")
+	}
+
+	subscribe := func(req *proto.AdminSubscribeRequest) {
+		stream := newFakeStream[proto.FrameData](t, 4)
+		serve(func() error { return s.SubscribeOverview(req, stream) })
+		waitUntil(t, "Overview 구독 등록", func() bool { return overviewSub(s, req.GetAdminId()) != nil })
+	}
+	// 필터 제외·포커스(원본 수신)·일시정지 구독은 미리보기를 받지 않음
+	subscribe(&proto.AdminSubscribeRequest{AdminId: "admin-1", AgentIds: []string{"agent-2"}})
+	subscribe(&proto.AdminSubscribeRequest{AdminId: "admin-2", FocusAgentId: "agent-1"})
+	subscribe(&proto.AdminSubscribeRequest{AdminId: "admin-3", Paused: true})
+	if s.overviewWantsPreview("agent-1") {
+		t.Fatal("미리보기를 받을 구독자가 없는데 미리보기 필요로 판단")
+	}
+	if !s.overviewWantsPreview("agent-2") {
+		t.Fatal("agent-2 필터 구독이 있는데 미리보기 불필요로 판단")
+	}
+
+	subscribe(&proto.AdminSubscribeRequest{AdminId: "admin-4"})
+	if !s.overviewWantsPreview("agent-1") {
+		t.Fatal("필터 없는 구독이 있는데 미리보기 불필요로 판단")
+	}
+}
+
+func TestPreviewSkippedForFocusedOnlySubscriber(t *testing.T) {
+	s := newTestService(t, WithPreviewTranscode(0, 0, 0))
+	stream := newFakeStream[proto.FrameData](t, 4)
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1", FocusAgentId: "agent-1"}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewSub(s, "admin-1") != nil })
+
+	original := testJPEG(t, 1280, 720)
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: original})
+	if got := stream.next(t); got.GetIsPreview() || !bytes.Equal(got.GetImageData(), original) {
+		t.Fatal("포커스 구독자가 원본을 받지 못함")
+	}
+}
@@ -0,0 +1,30 @@
+// validate.go: 구독 요청 입력 검증
+// 빈 adminId/agentId 로 구독하면 "" 키로 죽은 구독이 맵에 남으므로 등록 전에 InvalidArgument 로 거부합니다.
+
+package server
+
+import (
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateOverviewRequest는 Overview 구독 요청의 필수 필드를 검사합니다.
+func validateOverviewRequest(req *proto.AdminSubscribeRequest) error {
+	if req.GetAdminId() == "" {
+		return status.Error(codes.InvalidArgument, "adminId is required")
+	}
+	return nil
+}
+
+// validateDetailRequest는 Detail/Events 구독 요청의 필수 필드를 검사합니다.
+func validateDetailRequest(req *proto.AgentDetailRequest) error {
+	if req.GetAdminId() == "" {
+		return status.Error(codes.InvalidArgument, "adminId is required")
+	}
+	if req.GetAgentId() == "" {
+		return status.Errorf(codes.InvalidArgument, "agentId is required (adminId %q)", req.GetAdminId())
+	}
+	return nil
+}
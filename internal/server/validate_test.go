@@ -0,0 +1,48 @@
+package server
+
+import (
+	"testing"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubscribeRejectsEmptyIds(t *testing.T) {
+	s := newTestService(t)
+	frames := func() *fakeStream[proto.FrameData] { return newFakeStream[proto.FrameData](t, 1) }
+	events := func() *fakeStream[proto.EventData] { return newFakeStream[proto.EventData](t, 1) }
+	cases := []struct {
+		name string
+		call func() error
+	}{
+		{"overview adminId", func() error {
+			return s.SubscribeOverview(&proto.AdminSubscribeRequest{}, frames())
+		}},
+		{"overview batch adminId", func() error {
+			return s.SubscribeOverviewBatch(&proto.AdminSubscribeRequest{}, newFakeStream[proto.FrameBatch](t, 1))
+		}},
+		{"detail adminId", func() error {
+			return s.SubscribeDetail(&proto.AgentDetailRequest{AgentId: "agent-1"}, frames())
+		}},
+		{"detail agentId", func() error {
+			return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1"}, frames())
+		}},
+		{"events adminId", func() error {
+			return s.SubscribeEvents(&proto.AgentDetailRequest{AgentId: "agent-1"}, events())
+		}},
+		{"events agentId", func() error {
+			return s.SubscribeEvents(&proto.AgentDetailRequest{AdminId: "admin-1"}, events())
+		}},
+	}
+	for _, tc := range cases {
+		err := waitErr(t, serve(tc.call))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s 누락 오류 = %v, want InvalidArgument", tc.name, err)
+		}
+	}
+	if st := s.Stats(); st.ActiveSubscribers != 0 {
+		t.Fatalf("거부된 요청으로 등록된 구독자 = %+v", st)
+	}
+}
@@ -0,0 +1,145 @@
+// watchers.go: Agent 별 시청자 수 (참조 카운트)
+// Agent 가 아무도 보지 않을 때 캡처를 멈출 수 있도록, 해당 Agent 프레임을 실제로 받는 Overview/Detail 구독자 수를 제공합니다.
+// Overview 는 Agent 필터가 없거나 필터에 포함된 구독, Detail 은 해당 Agent 구독과 전체("*") 구독을 셉니다.
+// 일시정지된 구독은 프레임을 받지 않으므로 세지 않습니다.
+// OnWatcherCountChange 콜백은 구독/해지·일시정지/재개 시와 새 Agent 의 첫 프레임이 캐시에 들어올 때 mu 아래에서 계산한
+// 0↔1 이상 전환에만 호출되며 (필터 없는 Overview 구독이 이미 있으면 첫 프레임 시점에 "시청 중" 이 됨),
+// 대상 Agent 는 캐시에 있는 Agent, 구독에서 지정된 Agent, 이전에 시청 중이던 Agent 입니다.
+// 콜백은 mu 를 놓은 뒤 호출 순서대로 직렬 실행되므로 콜백 안에서 WatcherCount 를 호출해도 됩니다.
+
+package server
+
+import "sync"
+
+// watcherChange는 시청 여부가 바뀐 Agent 와 바뀐 뒤 시청자 수입니다.
+type watcherChange struct {
+	agentId string
+	count   int
+}
+
+// watcherState는 콜백과 마지막으로 알린 시청 중 Agent 집합입니다. (AdminService.mu 로 보호)
+type watcherState struct {
+	onChange func(agentId string, count int)
+	watched  map[string]struct{}
+	// 콜백 호출 직렬화 (mu 해제 후에도 계산 순서대로 전달)
+	notifyMu sync.Mutex
+}
+
+// WatcherCount는 해당 Agent 의 프레임을 받는 Overview + Detail 구독자 수를 반환합니다.
+func (s *AdminService) WatcherCount(agentId string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.watcherCountLocked(s.normalizeAgentId(agentId))
+}
+
+// OnWatcherCountChange는 Agent 시청자 수가 0 에서 1 이상으로, 또는 1 이상에서 0 으로 바뀔 때 호출할 콜백을 등록합니다.
+// 등록 시점의 상태를 기준으로 이후 전환만 알리며, nil 이면 해제합니다.
+func (s *AdminService) OnWatcherCountChange(fn func(agentId string, count int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.watchers.onChange = fn
+	s.watchers.watched = nil
+	if fn == nil {
+		return
+	}
+	s.watchers.watched = make(map[string]struct{})
+	for _, agentId := range s.watcherCandidatesLocked() {
+		if s.watcherCountLocked(agentId) > 0 {
+			s.watchers.watched[agentId] = struct{}{}
+		}
+	}
+}
+
+// watcherCountLocked는 WatcherCount 의 잠금 없는 버전입니다. (mu 읽기/쓰기 잠금 상태에서 호출)
+func (s *AdminService) watcherCountLocked(agentId string) int {
+	count := activeCount(s.detailIndex[agentId])
+	if agentId != WILDCARD_AGENT_ID {
+		count += activeCount(s.detailIndex[WILDCARD_AGENT_ID])
+	}
+	for _, sub := range s.overviewSubs {
+		if !sub.paused.Load() && sub.acceptsAgent(agentId) {
+			count++
+		}
+	}
+	return count
+}
+
+// activeCount는 일시정지되지 않은 구독자 수입니다.
+func activeCount(subs map[*adminSubscriber]struct{}) int {
+	count := 0
+	for sub := range subs {
+		if !sub.paused.Load() {
+			count++
+		}
+	}
+	return count
+}
+
+// watcherCandidatesLocked는 시청 여부를 다시 계산할 Agent 목록입니다. (mu 잠금 상태에서 호출)
+func (s *AdminService) watcherCandidatesLocked() []string {
+	seen := make(map[string]struct{})
+	for agentId := range s.watchers.watched {
+		seen[agentId] = struct{}{}
+	}
+	for _, entry := range s.lastFrames.entries() {
+		seen[entry.frame.GetAgentId()] = struct{}{}
+	}
+	for agentId := range s.detailIndex {
+		seen[agentId] = struct{}{}
+	}
+	for _, sub := range s.overviewSubs {
+		for agentId := range sub.agents() {
+			seen[agentId] = struct{}{}
+		}
+	}
+	delete(seen, WILDCARD_AGENT_ID)
+	list := make([]string, 0, len(seen))
+	for agentId := range seen {
+		list = append(list, agentId)
+	}
+	return list
+}
+
+// watcherChangesLocked는 마지막 알림 이후 시청 여부가 바뀐 Agent 를 계산하고 상태를 갱신합니다. (mu 쓰기 잠금 상태에서 호출)
+func (s *AdminService) watcherChangesLocked() []watcherChange {
+	if s.watchers.onChange == nil {
+		return nil
+	}
+	var changes []watcherChange
+	for _, agentId := range s.watcherCandidatesLocked() {
+		count := s.watcherCountLocked(agentId)
+		_, was := s.watchers.watched[agentId]
+		switch {
+		case count > 0 && !was:
+			s.watchers.watched[agentId] = struct{}{}
+			changes = append(changes, watcherChange{agentId: agentId, count: count})
+		case count == 0 && was:
+			delete(s.watchers.watched, agentId)
+			changes = append(changes, watcherChange{agentId: agentId, count: 0})
+		}
+	}
+	return changes
+}
+
+// notifyWatchers는 구독 변경 없이 시청 여부를 다시 계산해 알립니다. (새 Agent 가 캐시에 처음 등장했을 때)
+func (s *AdminService) notifyWatchers() {
+	s.mu.Lock()
+	s.unlockAndNotifyWatchers()
+}
+
+// unlockAndNotifyWatchers는 시청 여부 전환을 계산한 뒤 mu 를 해제하고 콜백을 호출합니다.
+// 구독 맵을 변경한 곳에서 mu.Unlock() 대신 호출합니다.
+func (s *AdminService) unlockAndNotifyWatchers() {
+	changes := s.watcherChangesLocked()
+	fn := s.watchers.onChange
+	if len(changes) == 0 {
+		s.mu.Unlock()
+		return
+	}
+	s.watchers.notifyMu.Lock()
+	s.mu.Unlock()
+	defer s.watchers.notifyMu.Unlock()
+	for _, c := range changes {
+		fn(c.agentId, c.count)
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// watchChanges는 OnWatcherCountChange 콜백 호출을 채널로 전달합니다.
+func watchChanges(s *AdminService) <-chan watcherChange {
+	ch := make(chan watcherChange, 16)
+	s.OnWatcherCountChange(func(agentId string, count int) {
+		ch <- watcherChange{agentId: agentId, count: count}
+	})
+	return ch
+}
+
+// expectChange는 다음 콜백 호출이 want 인지 확인합니다.
+func expectChange(t *testing.T, ch <-chan watcherChange, want watcherChange) {
+	t.Helper()
+	select {
+	case got := <-ch:
+		if got != want {
+			t.Fatalf("시청자 수 변경 = %+v, want %+v", got, want)
+		}
+	case <-time.After(TEST_WAIT_TIMEOUT):
+		t.Fatalf("시청자 수 변경 %+v 대기 시간 초과", want)
+	}
+}
+
+// expectNoChange는 TEST_QUIET_PERIOD 동안 콜백이 호출되지 않았는지 확인합니다.
+func expectNoChange(t *testing.T, ch <-chan watcherChange) {
+	t.Helper()
+	select {
+	case got := <-ch:
+		t.Fatalf("예상하지 않은 시청자 수 변경: %+v", got)
+	case <-time.After(TEST_QUIET_PERIOD):
+	}
+}
+
+func TestWatcherCountTransitions(t *testing.T) {
+	s := newTestService(t)
+	changes := watchChanges(s)
+
+	first := newFakeStream[proto.FrameData](t, 1)
+	firstErr := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-1", AgentId: "agent-1"}, first)
+	})
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 1})
+
+	// 1 → 2 는 전환이 아니므로 알리지 않음
+	second := newFakeStream[proto.FrameData](t, 1)
+	secondErr := serve(func() error {
+		return s.SubscribeDetail(&proto.AgentDetailRequest{AdminId: "admin-2", AgentId: "agent-1"}, second)
+	})
+	waitUntil(t, "두 번째 구독 등록", func() bool { return s.WatcherCount("agent-1") == 2 })
+	expectNoChange(t, changes)
+
+	first.cancel()
+	waitErr(t, firstErr)
+	if got := s.WatcherCount("agent-1"); got != 1 {
+		t.Fatalf("구독 해지 후 WatcherCount = %d, want 1", got)
+	}
+	expectNoChange(t, changes)
+
+	second.cancel()
+	waitErr(t, secondErr)
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 0})
+}
+
+func TestWatcherCountFirstFrameUnderOverview(t *testing.T) {
+	s := newTestService(t)
+	stream := newFakeStream[proto.FrameData](t, 1)
+	stream.discard()
+	serve(func() error {
+		return s.SubscribeOverview(&proto.AdminSubscribeRequest{AdminId: "admin-1"}, stream)
+	})
+	waitUntil(t, "Overview 구독 등록", func() bool { return overviewCount(s) == 1 })
+	changes := watchChanges(s)
+
+	// 필터 없는 Overview 가 이미 있으면 새 Agent 의 첫 프레임에서 시청 중이 됨
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 1})
+	s.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img2")})
+	expectNoChange(t, changes)
+
+	stream.cancel()
+	expectChange(t, changes, watcherChange{agentId: "agent-1", count: 0})
+}
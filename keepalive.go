@@ -0,0 +1,41 @@
+package main
+
+// gRPC keepalive (클라이언트)
+// - 서버 호스트가 RST 없이 죽으면 스트림이 OS TCP 타임아웃(수 분)까지 멈춰 재연결이 늦어짐
+// - 주기적으로 ping 을 보내 응답이 없으면 연결을 끊어 bootstrapLoop 재연결을 수 초 안에 시작
+// - ADMIN_GRPC_KEEPALIVE_MS / ADMIN_GRPC_KEEPALIVE_TIMEOUT_MS 로 조정, 간격 0 이면 비활성
+// - 서버 허용 최소 간격(기본 5초)보다 짧으면 서버가 GOAWAY 로 끊으므로 그 이상으로 설정
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// keepalive 환경변수 이름
+	ENV_GRPC_KEEPALIVE_MS         = "ADMIN_GRPC_KEEPALIVE_MS"
+	ENV_GRPC_KEEPALIVE_TIMEOUT_MS = "ADMIN_GRPC_KEEPALIVE_TIMEOUT_MS"
+	// keepalive ping 간격 / 응답 대기 시간 기본값
+	GRPC_KEEPALIVE_MS         = 10000
+	GRPC_KEEPALIVE_TIMEOUT_MS = 5000
+)
+
+// keepaliveDialOption 환경변수 설정에 따른 keepalive 연결 옵션을 반환합니다. 비활성이면 false 입니다.
+func keepaliveDialOption() (grpc.DialOption, bool) {
+	interval := envInt(ENV_GRPC_KEEPALIVE_MS, GRPC_KEEPALIVE_MS)
+	if interval <= 0 {
+		return nil, false
+	}
+	timeout := envInt(ENV_GRPC_KEEPALIVE_TIMEOUT_MS, GRPC_KEEPALIVE_TIMEOUT_MS)
+	if timeout <= 0 {
+		timeout = GRPC_KEEPALIVE_TIMEOUT_MS
+	}
+	return grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:    time.Duration(interval) * time.Millisecond,
+		Timeout: time.Duration(timeout) * time.Millisecond,
+		// Overview 를 꺼 둔 동안처럼 활성 스트림이 없어도 연결 상태 감시
+		PermitWithoutStream: true,
+	}), true
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestKeepaliveDialOption(t *testing.T) {
+	if _, ok := keepaliveDialOption(); !ok {
+		t.Fatal("기본 설정에서 keepalive 옵션이 없음")
+	}
+	app, _ := newTestApp()
+	withKeepalive, err := app.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(ENV_GRPC_KEEPALIVE_MS, "0")
+	if _, ok := keepaliveDialOption(); ok {
+		t.Fatalf("%s=0 인데 keepalive 옵션이 있음", ENV_GRPC_KEEPALIVE_MS)
+	}
+	without, err := app.dialOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// keepalive 옵션이 연결 옵션에 포함되는지 확인
+	if len(withKeepalive) != len(without)+1 {
+		t.Fatalf("keepalive 포함 dialOptions 수 = %d, 비활성 = %d", len(withKeepalive), len(without))
+	}
+}
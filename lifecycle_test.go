@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"admin/internal/server/servertest"
+
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestShutdownStopsStreamGoroutines(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	baseline := runtime.NumGoroutine()
+
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+	if err := app.StartDetail("agent-1"); err != nil {
+		t.Fatalf("StartDetail 오류 = %v", err)
+	}
+	if err := app.StartEvents("agent-1"); err != nil {
+		t.Fatalf("StartEvents 오류 = %v", err)
+	}
+	waitFor(t, "서버 구독 등록", nil, func() bool {
+		st := h.Service.Stats()
+		return st.OverviewSubscribers == 1 && st.DetailSubscribers == 1 && st.EventSubscribers == 1
+	})
+
+	app.shutdown(context.Background())
+	app.detailMu.Lock()
+	details := len(app.detailStreams)
+	app.detailMu.Unlock()
+	app.eventsMu.Lock()
+	events := len(app.eventStreams)
+	app.eventsMu.Unlock()
+	if details != 0 || events != 0 {
+		t.Fatalf("shutdown 후 남은 스트림 detail=%d events=%d", details, events)
+	}
+	waitFor(t, "서버 구독 해제", nil, func() bool { return h.Service.Stats().ActiveSubscribers == 0 })
+	// 수신/재연결/주기 루프와 gRPC 연결 고루틴이 모두 끝나 시작 전 수준으로 돌아와야 함
+	waitFor(t, "App 고루틴 정리", nil, func() bool { return runtime.NumGoroutine() <= baseline })
+}
+
+func TestLifecycleHooksIdempotent(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	// startup 전 shutdown 은 아무 일도 하지 않아야 함
+	NewApp().shutdown(context.Background())
+
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+	ctx := app.ctx
+	app.startup(context.Background())
+	if app.ctx != ctx {
+		t.Fatal("startup 중복 호출이 context 를 교체함")
+	}
+	app.connMu.Lock()
+	conn := app.conn
+	app.connMu.Unlock()
+
+	app.shutdown(context.Background())
+	app.shutdown(context.Background())
+	if app.ctx.Err() == nil {
+		t.Fatal("shutdown 후 App context 가 취소되지 않음")
+	}
+	if app.client() != nil {
+		t.Fatal("shutdown 후 클라이언트가 남아 있음")
+	}
+	if state := conn.GetState(); state != connectivity.Shutdown {
+		t.Fatalf("shutdown 후 연결 상태 = %s, want SHUTDOWN", state)
+	}
+}
+
+// 시작/종료 경쟁 테스트 반복 횟수
+const LIFECYCLE_RACE_ROUNDS = 20
+
+// TestStartupShutdownRace는 bootstrapLoop 가 연결을 맺는 도중 shutdown 과 연결 조회 메서드가
+// 동시에 호출되어도 data race 나 남는 연결이 없는지 확인합니다. (go test -race)
+func TestStartupShutdownRace(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	for round := range LIFECYCLE_RACE_ROUNDS {
+		app, _ := newTestApp(WithDialer(h.Dialer()))
+		app.tls = tlsSettings{Insecure: true}
+		app.startup(context.Background())
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range round {
+				_ = app.client()
+				_, _ = app.Ping()
+				_ = app.StartDetail("agent-1")
+			}
+		}()
+		app.shutdown(context.Background())
+		<-done
+
+		if app.client() != nil {
+			t.Fatalf("round %d: shutdown 후 연결이 남아 있음", round)
+		}
+	}
+	waitFor(t, "서버 구독 정리", nil, func() bool { return h.Service.Stats().ActiveSubscribers == 0 })
+}
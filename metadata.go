@@ -0,0 +1,75 @@
+package main
+
+// Agent 메타데이터 (표시 이름 / 태그)
+// - 서버 ListAgents 응답의 name/tags 를 캐시해 타일에 원시 ID 대신 이름을 표시
+// - GetAgents 호출과 metadataLoop 주기 갱신 시 캐시를 교체
+// - 연결 전이거나 조회 실패 시 마지막 캐시(없으면 빈 값) 반환
+// - GetLatestFrames 등 스냅샷 조회 결과에 이름/태그를 합쳐 프론트가 하나의 모델로 사용
+
+import (
+	"maps"
+	"time"
+)
+
+const (
+	// 메타데이터 주기 갱신 간격
+	AGENT_METADATA_REFRESH_MS = 30 * 1000
+)
+
+// agentMetadata는 프론트로 전달하는 Agent 표시 정보입니다.
+type agentMetadata struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+// setAgentMetadata ListAgents 결과로 메타데이터 캐시를 교체합니다.
+func (a *App) setAgentMetadata(byId map[string]agentMetadata) {
+	a.metaMu.Lock()
+	a.agentMeta = byId
+	a.metaMu.Unlock()
+}
+
+// agentMetadataOf 캐시된 Agent 메타데이터를 반환합니다. 없으면 빈 값입니다.
+func (a *App) agentMetadataOf(agentId string) agentMetadata {
+	a.metaMu.RLock()
+	defer a.metaMu.RUnlock()
+	return a.agentMeta[agentId]
+}
+
+// GetAgentMetadata 서버에서 Agent 메타데이터를 새로 받아 agentId 별로 반환합니다.
+// 연결 전이거나 조회에 실패하면 마지막으로 받은 값을 반환합니다.
+func (a *App) GetAgentMetadata() map[string]agentMetadata {
+	a.GetAgents()
+	a.metaMu.RLock()
+	defer a.metaMu.RUnlock()
+	out := make(map[string]agentMetadata, len(a.agentMeta))
+	for agentId, md := range a.agentMeta {
+		md.Tags = maps.Clone(md.Tags)
+		out[agentId] = md
+	}
+	return out
+}
+
+// withMetadata 스냅샷에 캐시된 이름/태그를 채웁니다.
+func (a *App) withMetadata(snap frameSnapshot) frameSnapshot {
+	md := a.agentMetadataOf(snap.AgentID)
+	snap.Name = md.Name
+	snap.Tags = md.Tags
+	return snap
+}
+
+// metadataLoop 주기적으로 메타데이터 캐시를 갱신합니다.
+func (a *App) metadataLoop() {
+	ticker := time.NewTicker(AGENT_METADATA_REFRESH_MS * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			if a.client() != nil {
+				a.GetAgents()
+			}
+		}
+	}
+}
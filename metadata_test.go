@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"admin/internal/server"
+	"admin/internal/server/servertest"
+)
+
+func TestAgentMetadataFromServer(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	if err := h.Service.SetAgentMetadata("agent-1", server.AgentMetadata{Name: "Lobby", Tags: map[string]string{"floor": "1"}}); err != nil {
+		t.Fatalf("SetAgentMetadata: %v", err)
+	}
+	pushFrame(h, "agent-1", "img")
+	pushFrame(h, "agent-2", "img")
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+
+	meta := app.GetAgentMetadata()
+	if md := meta["agent-1"]; md.Name != "Lobby" || md.Tags["floor"] != "1" {
+		t.Fatalf("agent-1 메타데이터 = %+v, want Lobby floor=1", md)
+	}
+	if _, ok := meta["agent-2"]; ok {
+		t.Fatalf("메타데이터 없는 agent-2 가 포함됨: %+v", meta)
+	}
+
+	// 스냅샷 조회에도 이름/태그가 합쳐짐
+	waitFor(t, "agent-1 스냅샷", func() { pushFrame(h, "agent-1", "img") }, func() bool {
+		_, ok := app.GetLatestFrame("agent-1")
+		return ok
+	})
+	if snap, _ := app.GetLatestFrame("agent-1"); snap.Name != "Lobby" || snap.Tags["floor"] != "1" {
+		t.Fatalf("스냅샷 메타데이터 = %q %v, want Lobby floor=1", snap.Name, snap.Tags)
+	}
+}
+
+func TestAgentMetadataCachedWhenDisconnected(t *testing.T) {
+	app, _ := newTestApp()
+	if meta := app.GetAgentMetadata(); meta == nil || len(meta) != 0 {
+		t.Fatalf("연결 전 GetAgentMetadata = %v, want 빈 맵", meta)
+	}
+	app.setAgentMetadata(map[string]agentMetadata{"agent-1": {Name: "Lobby"}})
+	if meta := app.GetAgentMetadata(); meta["agent-1"].Name != "Lobby" {
+		t.Fatalf("연결 없이 GetAgentMetadata = %v, want 캐시된 Lobby", meta)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+// 오프라인 표시 유예 (debounce)
+// - 순간적인 네트워크 끊김에도 서버가 오프라인 신호를 보내 타일이 깜빡이므로, 유예 시간 동안 반영을 미룸
+// - 유예 시간 안에 같은 Agent 의 실제 프레임이 오면 대기 중인 오프라인 처리를 취소
+// - Agent 별 타이머는 framesMu 로 보호 (storeFrame 에서 취소)
+// - SetOfflineGrace 로 유예 시간 변경, 0 이하이면 즉시 반영
+
+import (
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 오프라인 신호 반영 전 기본 유예 시간
+	OFFLINE_GRACE_MS = 2000
+)
+
+// SetOfflineGrace 오프라인 신호 반영 유예 시간(ms)을 설정합니다. 0 이하이면 즉시 반영합니다.
+func (a *App) SetOfflineGrace(ms int) {
+	a.offlineGraceMs.Store(int64(ms))
+}
+
+// handleOfflineFrame 유예 시간 후 오프라인 신호를 캐시/프론트에 반영합니다.
+// 대기 중인 오프라인 처리가 있으면 새 신호로 교체합니다.
+func (a *App) handleOfflineFrame(frame *proto.FrameData, server string) {
+	grace := time.Duration(a.offlineGraceMs.Load()) * time.Millisecond
+	if grace <= 0 {
+		a.applyOfflineFrame(frame, server)
+		return
+	}
+	agentId := frame.GetAgentId()
+	a.framesMu.Lock()
+	defer a.framesMu.Unlock()
+	if prev, ok := a.offlineTimers[agentId]; ok {
+		prev.Stop()
+	}
+	var timer *time.Timer
+	// 콜백은 framesMu 를 잡아야 하므로 timer 대입이 끝난 뒤에 실행됨
+	timer = time.AfterFunc(grace, func() {
+		a.framesMu.Lock()
+		if a.offlineTimers[agentId] != timer {
+			a.framesMu.Unlock()
+			return
+		}
+		delete(a.offlineTimers, agentId)
+		a.framesMu.Unlock()
+		a.applyOfflineFrame(frame, server)
+	})
+	a.offlineTimers[agentId] = timer
+}
+
+// applyOfflineFrame 오프라인 신호를 캐시에 저장하고 프론트로 발행합니다.
+func (a *App) applyOfflineFrame(frame *proto.FrameData, server string) {
+	a.storeFrame(frame, "", server)
+	a.emitOverview(frame.GetAgentId(), frameEventPayload(frame, "", server))
+}
+
+// cancelOfflineLocked 대기 중인 오프라인 처리를 취소합니다. (framesMu 잠금 상태에서 호출)
+func (a *App) cancelOfflineLocked(agentId string) {
+	if timer, ok := a.offlineTimers[agentId]; ok {
+		timer.Stop()
+		delete(a.offlineTimers, agentId)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+// 오프라인 유예 테스트에서 사용하는 유예 시간
+const TEST_OFFLINE_GRACE_MS = 50
+
+// offlineEmits agentId 의 offline 표시 overviewFrame 이벤트 수를 반환합니다.
+func offlineEmits(rec *eventRecorder, agentId string) int {
+	n := 0
+	for _, data := range rec.named(EVENT_OVERVIEW_FRAME) {
+		payload, _ := data.(map[string]any)
+		if payload["agentId"] == agentId && payload["offline"] == true {
+			n++
+		}
+	}
+	return n
+}
+
+// newOfflineTestApp 발행 속도 제한 없이 오프라인 유예만 설정한 App 을 생성합니다.
+func newOfflineTestApp() (*App, *eventRecorder) {
+	app, rec := newTestApp()
+	app.SetOverviewEmitRate(0)
+	app.SetOfflineGrace(TEST_OFFLINE_GRACE_MS)
+	return app, rec
+}
+
+func TestOfflineCanceledByFrameWithinGrace(t *testing.T) {
+	app, rec := newOfflineTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("before"), Timestamp: 1000})
+	app.handleOfflineFrame(&proto.FrameData{AgentId: "agent-1", Offline: true}, app.serverAddress())
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("after"), Timestamp: 2000})
+
+	time.Sleep(3 * TEST_OFFLINE_GRACE_MS * time.Millisecond)
+	if n := offlineEmits(rec, "agent-1"); n != 0 {
+		t.Fatalf("유예 시간 안에 프레임이 왔는데 offline 발행 = %d", n)
+	}
+	if snap, _ := app.GetLatestFrame("agent-1"); snap.Offline || snap.Timestamp != 2000 {
+		t.Fatalf("캐시 = %+v, want 온라인 최신 프레임", snap)
+	}
+}
+
+func TestOfflineAppliedAfterGrace(t *testing.T) {
+	app, rec := newOfflineTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("before"), Timestamp: 1000})
+	app.handleOfflineFrame(&proto.FrameData{AgentId: "agent-1", Offline: true}, app.serverAddress())
+	if n := offlineEmits(rec, "agent-1"); n != 0 {
+		t.Fatalf("유예 시간 전 offline 발행 = %d", n)
+	}
+
+	waitFor(t, "유예 후 offline 발행", nil, func() bool { return offlineEmits(rec, "agent-1") == 1 })
+	if snap, _ := app.GetLatestFrame("agent-1"); !snap.Offline {
+		t.Fatalf("캐시 = %+v, want offline", snap)
+	}
+}
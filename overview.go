@@ -0,0 +1,80 @@
+package main
+
+// Overview 스트림 켜기/끄기
+// - UI 에서 그리드를 숨길 때 연결 전체가 아닌 Overview 스트림만 끊기 위해 Overview 전용 cancel 을 별도 관리
+// - StopOverview 후에도 연결, Detail/Events 스트림은 유지되고 bootstrapLoop 도 재연결하지 않음
+// - 끈 상태는 재연결 후에도 유지되며, StartOverview 로 현재 연결에서 다시 구독
+
+import (
+	"context"
+	"log"
+)
+
+// setOverviewEnabled StopOverview/StartOverview 공통 처리입니다.
+func (a *App) setOverviewEnabled(enabled bool) {
+	a.overviewMu.Lock()
+	a.overviewOff = !enabled
+	cancel := a.overviewCancel
+	a.overviewMu.Unlock()
+	if !enabled && cancel != nil {
+		cancel()
+	}
+	// 대기 중인 runOverview 를 깨움
+	select {
+	case a.overviewToggle <- struct{}{}:
+	default:
+	}
+}
+
+// StopOverview 연결과 Detail/Events 스트림은 유지한 채 Overview 스트림만 중지합니다.
+func (a *App) StopOverview() {
+	log.Printf("[Admin][STREAM] overview 중지 요청")
+	a.setOverviewEnabled(false)
+}
+
+// StartOverview 중지했던 Overview 스트림을 현재 연결에서 다시 시작합니다.
+func (a *App) StartOverview() {
+	log.Printf("[Admin][STREAM] overview 시작 요청")
+	a.setOverviewEnabled(true)
+}
+
+// IsOverviewEnabled Overview 스트림 사용 여부를 반환합니다.
+func (a *App) IsOverviewEnabled() bool {
+	a.overviewMu.Lock()
+	defer a.overviewMu.Unlock()
+	return !a.overviewOff
+}
+
+// runOverview 연결이 유지되는 동안 Overview 켜기/끄기에 맞춰 구독을 열고 닫습니다.
+// StopOverview 로 끊긴 경우는 연결 오류로 보지 않고 다시 켜질 때까지 대기하며,
+// 그 외 스트림 종료는 연결 단위 재연결을 위해 그대로 반환합니다.
+func (a *App) runOverview(ctx context.Context) error {
+	for {
+		a.overviewMu.Lock()
+		if a.overviewOff {
+			a.overviewMu.Unlock()
+			a.setConnectionStatus(CONNECTION_STATE_CONNECTED, nil)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-a.overviewToggle:
+			}
+			continue
+		}
+		octx, cancel := context.WithCancel(ctx)
+		a.overviewCancel = cancel
+		a.overviewMu.Unlock()
+
+		err := a.subscribeOverview(octx)
+
+		a.overviewMu.Lock()
+		a.overviewCancel = nil
+		stoppedByUser := a.overviewOff
+		a.overviewMu.Unlock()
+		cancel()
+		if ctx.Err() != nil || !stoppedByUser {
+			return err
+		}
+		log.Printf("[Admin][STREAM] overview 구독 중지: %s", a.adminID)
+	}
+}
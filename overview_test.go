@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+)
+
+func TestToggleOverviewKeepsDetailAlive(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	app.SetOverviewEmitRate(0)
+	waitConnected(t, app)
+	if err := app.StartDetail("agent-1"); err != nil {
+		t.Fatalf("StartDetail 오류 = %v", err)
+	}
+	waitFor(t, "Overview/Detail 구독 등록", nil, func() bool {
+		st := h.Service.Stats()
+		return st.OverviewSubscribers == 1 && st.DetailSubscribers == 1
+	})
+	client := app.client()
+
+	app.StopOverview()
+	if app.IsOverviewEnabled() {
+		t.Fatal("StopOverview 후 IsOverviewEnabled = true")
+	}
+	waitFor(t, "Overview 구독 해제", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 0 })
+	// 연결과 Detail 스트림은 그대로 유지
+	overviewBefore := len(rec.named(EVENT_OVERVIEW_FRAME))
+	detailBefore := len(rec.named(EVENT_DETAIL_FRAME_PREFIX + "agent-1"))
+	waitFor(t, "Overview 중지 중 detailFrame 수신", func() { pushFrame(h, "agent-1", "detail") }, func() bool {
+		return len(rec.named(EVENT_DETAIL_FRAME_PREFIX+"agent-1")) > detailBefore
+	})
+	time.Sleep(TEST_POLL_INTERVAL * 10)
+	if got := len(rec.named(EVENT_OVERVIEW_FRAME)); got != overviewBefore {
+		t.Fatalf("Overview 중지 중 overviewFrame 발행 %d → %d", overviewBefore, got)
+	}
+	if st := h.Service.Stats(); st.DetailSubscribers != 1 {
+		t.Fatalf("Overview 중지 중 Detail 구독자 = %d, want 1", st.DetailSubscribers)
+	}
+
+	app.StartOverview()
+	waitFor(t, "Overview 재구독 후 overviewFrame 수신", func() { pushFrame(h, "agent-1", "overview") }, func() bool {
+		return len(rec.named(EVENT_OVERVIEW_FRAME)) > overviewBefore
+	})
+	if app.client() != client {
+		t.Fatal("Overview 토글이 연결을 다시 맺음")
+	}
+	if st := h.Service.Stats(); st.OverviewSubscribers != 1 || st.DetailSubscribers != 1 {
+		t.Fatalf("StartOverview 후 구독자 = %+v", st)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+// Overview 일시정지/재개
+// - UI 가 백그라운드일 때 스트림은 유지한 채 서버가 Overview 프레임 전달을 멈추도록 요청 (서버에 쌓이지 않고 버려짐)
+// - 재개 시 서버가 Agent 별 최신 프레임부터 다시 보냄
+// - 일시정지 상태는 overviewMu 로 보관해 재연결 후 구독 요청(paused)에도 유지
+// - StopOverview 와 달리 구독을 닫지 않아 재개가 빠름
+
+import (
+	"log"
+
+	"admin/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PauseOverview Overview 프레임 전달을 일시정지합니다.
+func (a *App) PauseOverview() error {
+	return a.setOverviewPaused(true)
+}
+
+// ResumeOverview 일시정지한 Overview 프레임 전달을 재개합니다.
+func (a *App) ResumeOverview() error {
+	return a.setOverviewPaused(false)
+}
+
+// IsOverviewPaused Overview 일시정지 여부를 반환합니다.
+func (a *App) IsOverviewPaused() bool {
+	a.overviewMu.Lock()
+	defer a.overviewMu.Unlock()
+	return a.overviewPaused
+}
+
+// setOverviewPaused PauseOverview/ResumeOverview 공통 처리입니다. 연결 전이면 다음 구독부터 적용됩니다.
+func (a *App) setOverviewPaused(paused bool) error {
+	a.overviewMu.Lock()
+	a.overviewPaused = paused
+	a.overviewMu.Unlock()
+	log.Printf("[Admin][STREAM] overview paused=%v", paused)
+	if a.client() == nil {
+		return nil
+	}
+	err := a.sendControl(&proto.ControlRequest{
+		SubscriptionId: OVERVIEW_SUBSCRIPTION_ID,
+		Update:         &proto.ControlRequest_Paused{Paused: paused},
+	})
+	// Overview 를 꺼 두었거나 재구독 중이면 다음 구독 요청에 실려 적용됨
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+)
+
+// overviewImages 지금까지 발행된 Overview 이벤트의 imageBase64 목록입니다.
+func overviewImages(rec *eventRecorder) []string {
+	var out []string
+	for _, data := range rec.named(EVENT_OVERVIEW_FRAME) {
+		payload, _ := data.(map[string]any)
+		image, _ := payload["imageBase64"].(string)
+		out = append(out, image)
+	}
+	return out
+}
+
+func TestPauseOverviewSuppressesFrames(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	app.SetOverviewEmitRate(0)
+	waitConnected(t, app)
+	waitFor(t, "Overview 구독 등록", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+
+	// "live" → base64 "bGl2ZQ=="
+	waitFor(t, "일시정지 전 프레임", func() { pushFrame(h, "agent-1", "live") }, func() bool {
+		images := overviewImages(rec)
+		return len(images) > 0 && images[len(images)-1] == "bGl2ZQ=="
+	})
+
+	if err := app.PauseOverview(); err != nil {
+		t.Fatalf("PauseOverview: %v", err)
+	}
+	if !app.IsOverviewPaused() {
+		t.Fatal("PauseOverview 후 IsOverviewPaused = false")
+	}
+	// 일시정지 직전에 서버 큐에 있던 프레임이 도착할 수 있으므로 잠시 기다린 뒤 기준을 잡음
+	time.Sleep(100 * time.Millisecond)
+	before := len(overviewImages(rec))
+	pushFrame(h, "agent-1", "paused")
+	time.Sleep(200 * time.Millisecond)
+	if got := len(overviewImages(rec)); got != before {
+		t.Fatalf("일시정지 중 Overview 이벤트 %d → %d", before, got)
+	}
+
+	// 재개하면 일시정지 중 도착한 최신 프레임부터 전달 ("paused" → "cGF1c2Vk")
+	if err := app.ResumeOverview(); err != nil {
+		t.Fatalf("ResumeOverview: %v", err)
+	}
+	waitFor(t, "재개 후 최신 프레임", nil, func() bool {
+		images := overviewImages(rec)
+		return len(images) > before && images[len(images)-1] == "cGF1c2Vk"
+	})
+}
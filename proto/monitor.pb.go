@@ -21,6 +21,205 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FrameStatus int32
+
+const (
+	FrameStatus_FRAME_STATUS_LIVE    FrameStatus = 0 // 일반 프레임
+	FrameStatus_FRAME_STATUS_OFFLINE FrameStatus = 1 // Agent 오프라인
+	FrameStatus_FRAME_STATUS_ERROR   FrameStatus = 2 // Agent 측 오류 (캡처 실패 등, status_message 에 사유)
+)
+
+// Enum value maps for FrameStatus.
+var (
+	FrameStatus_name = map[int32]string{
+		0: "FRAME_STATUS_LIVE",
+		1: "FRAME_STATUS_OFFLINE",
+		2: "FRAME_STATUS_ERROR",
+	}
+	FrameStatus_value = map[string]int32{
+		"FRAME_STATUS_LIVE":    0,
+		"FRAME_STATUS_OFFLINE": 1,
+		"FRAME_STATUS_ERROR":   2,
+	}
+)
+
+func (x FrameStatus) Enum() *FrameStatus {
+	p := new(FrameStatus)
+	*p = x
+	return p
+}
+
+func (x FrameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_proto_enumTypes[0].Descriptor()
+}
+
+func (FrameStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_proto_enumTypes[0]
+}
+
+func (x FrameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameStatus.Descriptor instead.
+func (FrameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+type ImageFormat int32
+
+const (
+	ImageFormat_IMAGE_FORMAT_UNSPECIFIED ImageFormat = 0 // 미지정 (상태 신호 프레임 또는 서버가 판별 전)
+	ImageFormat_IMAGE_FORMAT_UNKNOWN     ImageFormat = 1 // 판별 불가 (원본 그대로 전달)
+	ImageFormat_IMAGE_FORMAT_JPEG        ImageFormat = 2
+	ImageFormat_IMAGE_FORMAT_PNG         ImageFormat = 3
+	ImageFormat_IMAGE_FORMAT_WEBP        ImageFormat = 4
+)
+
+// Enum value maps for ImageFormat.
+var (
+	ImageFormat_name = map[int32]string{
+		0: "IMAGE_FORMAT_UNSPECIFIED",
+		1: "IMAGE_FORMAT_UNKNOWN",
+		2: "IMAGE_FORMAT_JPEG",
+		3: "IMAGE_FORMAT_PNG",
+		4: "IMAGE_FORMAT_WEBP",
+	}
+	ImageFormat_value = map[string]int32{
+		"IMAGE_FORMAT_UNSPECIFIED": 0,
+		"IMAGE_FORMAT_UNKNOWN":     1,
+		"IMAGE_FORMAT_JPEG":        2,
+		"IMAGE_FORMAT_PNG":         3,
+		"IMAGE_FORMAT_WEBP":        4,
+	}
+)
+
+func (x ImageFormat) Enum() *ImageFormat {
+	p := new(ImageFormat)
+	*p = x
+	return p
+}
+
+func (x ImageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_proto_enumTypes[1].Descriptor()
+}
+
+func (ImageFormat) Type() protoreflect.EnumType {
+	return &file_proto_monitor_proto_enumTypes[1]
+}
+
+func (x ImageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageFormat.Descriptor instead.
+func (ImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{1}
+}
+
+type EventSeverity int32
+
+const (
+	EventSeverity_EVENT_SEVERITY_INFO    EventSeverity = 0
+	EventSeverity_EVENT_SEVERITY_WARNING EventSeverity = 1
+	EventSeverity_EVENT_SEVERITY_ERROR   EventSeverity = 2
+)
+
+// Enum value maps for EventSeverity.
+var (
+	EventSeverity_name = map[int32]string{
+		0: "EVENT_SEVERITY_INFO",
+		1: "EVENT_SEVERITY_WARNING",
+		2: "EVENT_SEVERITY_ERROR",
+	}
+	EventSeverity_value = map[string]int32{
+		"EVENT_SEVERITY_INFO":    0,
+		"EVENT_SEVERITY_WARNING": 1,
+		"EVENT_SEVERITY_ERROR":   2,
+	}
+)
+
+func (x EventSeverity) Enum() *EventSeverity {
+	p := new(EventSeverity)
+	*p = x
+	return p
+}
+
+func (x EventSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_proto_enumTypes[2].Descriptor()
+}
+
+func (EventSeverity) Type() protoreflect.EnumType {
+	return &file_proto_monitor_proto_enumTypes[2]
+}
+
+func (x EventSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSeverity.Descriptor instead.
+func (EventSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+type HealthCheckResponse_ServingStatus int32
+
+const (
+	HealthCheckResponse_SERVING     HealthCheckResponse_ServingStatus = 0
+	HealthCheckResponse_NOT_SERVING HealthCheckResponse_ServingStatus = 1 // Shutdown 이후
+)
+
+// Enum value maps for HealthCheckResponse_ServingStatus.
+var (
+	HealthCheckResponse_ServingStatus_name = map[int32]string{
+		0: "SERVING",
+		1: "NOT_SERVING",
+	}
+	HealthCheckResponse_ServingStatus_value = map[string]int32{
+		"SERVING":     0,
+		"NOT_SERVING": 1,
+	}
+)
+
+func (x HealthCheckResponse_ServingStatus) Enum() *HealthCheckResponse_ServingStatus {
+	p := new(HealthCheckResponse_ServingStatus)
+	*p = x
+	return p
+}
+
+func (x HealthCheckResponse_ServingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthCheckResponse_ServingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_proto_enumTypes[3].Descriptor()
+}
+
+func (HealthCheckResponse_ServingStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_proto_enumTypes[3]
+}
+
+func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthCheckResponse_ServingStatus.Descriptor instead.
+func (HealthCheckResponse_ServingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{17, 0}
+}
+
 // ====== 공통 메시지 ======
 type AgentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -148,6 +347,11 @@ type FrameData struct {
 	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"` // 인코딩된 이미지 (JPEG/PNG/WebP)
 	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	IsPreview     bool                   `protobuf:"varint,4,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty"` // true면 저해상도 미리보기, false면 고해상도
+	Offline       bool                   `protobuf:"varint,5,opt,name=offline,proto3" json:"offline,omitempty"`                      // true면 오프라인 신호 (구버전 호환: timestamp 0 + 빈 이미지도 오프라인으로 간주, 추후 제거)
+	Status        FrameStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=monitor.FrameStatus" json:"status,omitempty"`
+	StatusMessage string                 `protobuf:"bytes,7,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"` // status 가 ERROR 일 때 사유
+	Sequence      uint64                 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`                               // 서버가 부여하는 Agent 별 증가 번호 (1 부터, 상태 신호는 0). 서버 재시작 시 초기화
+	Format        ImageFormat            `protobuf:"varint,9,opt,name=format,proto3,enum=monitor.ImageFormat" json:"format,omitempty"`          // image_data 형식. Agent 가 비워 보내면 서버가 매직 바이트로 판별
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,12 +414,48 @@ func (x *FrameData) GetIsPreview() bool {
 	return false
 }
 
+func (x *FrameData) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *FrameData) GetStatus() FrameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FrameStatus_FRAME_STATUS_LIVE
+}
+
+func (x *FrameData) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *FrameData) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *FrameData) GetFormat() ImageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImageFormat_IMAGE_FORMAT_UNSPECIFIED
+}
+
 type EventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "keyboard", "mouse", "printer", "usb" 등
 	EventDetail   string                 `protobuf:"bytes,3,opt,name=event_detail,json=eventDetail,proto3" json:"event_detail,omitempty"`
 	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Severity      EventSeverity          `protobuf:"varint,5,opt,name=severity,proto3,enum=monitor.EventSeverity" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -278,6 +518,13 @@ func (x *EventData) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *EventData) GetSeverity() EventSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return EventSeverity_EVENT_SEVERITY_INFO
+}
+
 type StreamAck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -331,10 +578,19 @@ func (x *StreamAck) GetMessage() string {
 }
 
 type AdminSubscribeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AdminId         string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AgentIds        []string               `protobuf:"bytes,2,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`                           // 비어 있으면 전체 Agent 수신, 지정 시 해당 Agent 만 수신
+	SubscriptionId  string                 `protobuf:"bytes,3,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`         // 같은 admin 의 여러 Overview 구독 구분 (비어 있으면 서버가 생성)
+	BatchMaxFrames  uint32                 `protobuf:"varint,4,opt,name=batch_max_frames,json=batchMaxFrames,proto3" json:"batch_max_frames,omitempty"`      // SubscribeOverviewBatch: 묶음당 최대 프레임 수 (0 이면 서버 기본값)
+	BatchMaxDelayMs uint32                 `protobuf:"varint,5,opt,name=batch_max_delay_ms,json=batchMaxDelayMs,proto3" json:"batch_max_delay_ms,omitempty"` // SubscribeOverviewBatch: 묶음을 모으기 위해 기다리는 최대 시간 (0 이면 대기 없음)
+	PreviewsOnly    bool                   `protobuf:"varint,6,opt,name=previews_only,json=previewsOnly,proto3" json:"previews_only,omitempty"`              // true 면 미리보기(is_preview) 프레임만 수신 (상태 신호는 항상 전달)
+	Stride          uint32                 `protobuf:"varint,7,opt,name=stride,proto3" json:"stride,omitempty"`                                              // Agent 별 N 번째 프레임마다 1개만 수신 (0, 1 이면 전체, 상태 신호는 항상 전달)
+	Mosaic          bool                   `protobuf:"varint,8,opt,name=mosaic,proto3" json:"mosaic,omitempty"`                                              // true 면 개별 프레임 대신 서버가 합성한 모자이크 프레임(agent_id "__mosaic__")만 주기적으로 수신
+	FocusAgentId    string                 `protobuf:"bytes,9,opt,name=focus_agent_id,json=focusAgentId,proto3" json:"focus_agent_id,omitempty"`             // 이 Agent 는 미리보기 대신 원본 해상도 프레임을 같은 스트림으로 수신 (SetOverviewFocus 로 변경)
+	Paused          bool                   `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`                                             // true 면 일시정지 상태로 구독 시작 (Control paused=false 로 재개)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AdminSubscribeRequest) Reset() {
@@ -374,28 +630,92 @@ func (x *AdminSubscribeRequest) GetAdminId() string {
 	return ""
 }
 
-type AgentDetailRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	AgentId       string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AdminSubscribeRequest) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
 }
 
-func (x *AgentDetailRequest) Reset() {
-	*x = AgentDetailRequest{}
+func (x *AdminSubscribeRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *AdminSubscribeRequest) GetBatchMaxFrames() uint32 {
+	if x != nil {
+		return x.BatchMaxFrames
+	}
+	return 0
+}
+
+func (x *AdminSubscribeRequest) GetBatchMaxDelayMs() uint32 {
+	if x != nil {
+		return x.BatchMaxDelayMs
+	}
+	return 0
+}
+
+func (x *AdminSubscribeRequest) GetPreviewsOnly() bool {
+	if x != nil {
+		return x.PreviewsOnly
+	}
+	return false
+}
+
+func (x *AdminSubscribeRequest) GetStride() uint32 {
+	if x != nil {
+		return x.Stride
+	}
+	return 0
+}
+
+func (x *AdminSubscribeRequest) GetMosaic() bool {
+	if x != nil {
+		return x.Mosaic
+	}
+	return false
+}
+
+func (x *AdminSubscribeRequest) GetFocusAgentId() string {
+	if x != nil {
+		return x.FocusAgentId
+	}
+	return ""
+}
+
+func (x *AdminSubscribeRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type OverviewFocusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AdminId        string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // 대상 Overview 구독 ID
+	FocusAgentId   string                 `protobuf:"bytes,3,opt,name=focus_agent_id,json=focusAgentId,proto3" json:"focus_agent_id,omitempty"`     // 비어 있으면 포커스 해제
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OverviewFocusRequest) Reset() {
+	*x = OverviewFocusRequest{}
 	mi := &file_proto_monitor_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentDetailRequest) String() string {
+func (x *OverviewFocusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentDetailRequest) ProtoMessage() {}
+func (*OverviewFocusRequest) ProtoMessage() {}
 
-func (x *AgentDetailRequest) ProtoReflect() protoreflect.Message {
+func (x *OverviewFocusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_monitor_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,124 +727,1036 @@ func (x *AgentDetailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentDetailRequest.ProtoReflect.Descriptor instead.
-func (*AgentDetailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OverviewFocusRequest.ProtoReflect.Descriptor instead.
+func (*OverviewFocusRequest) Descriptor() ([]byte, []int) {
 	return file_proto_monitor_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AgentDetailRequest) GetAdminId() string {
+func (x *OverviewFocusRequest) GetAdminId() string {
 	if x != nil {
 		return x.AdminId
 	}
 	return ""
 }
 
-func (x *AgentDetailRequest) GetAgentId() string {
+func (x *OverviewFocusRequest) GetSubscriptionId() string {
 	if x != nil {
-		return x.AgentId
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-var File_proto_monitor_proto protoreflect.FileDescriptor
+func (x *OverviewFocusRequest) GetFocusAgentId() string {
+	if x != nil {
+		return x.FocusAgentId
+	}
+	return ""
+}
 
-const file_proto_monitor_proto_rawDesc = "" +
-	"\n" +
-	"\x13proto/monitor.proto\x12\amonitor\"R\n" +
-	"\tAgentInfo\x12\x19\n" +
-	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1a\n" +
-	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x0e\n" +
-	"\x02ip\x18\x03 \x01(\tR\x02ip\"R\n" +
-	"\tAdminInfo\x12\x19\n" +
-	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x1a\n" +
-	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x0e\n" +
-	"\x02ip\x18\x03 \x01(\tR\x02ip\"\x82\x01\n" +
-	"\tFrameData\x12\x19\n" +
-	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1d\n" +
-	"\n" +
-	"image_data\x18\x02 \x01(\fR\timageData\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1d\n" +
-	"\n" +
-	"is_preview\x18\x04 \x01(\bR\tisPreview\"\x86\x01\n" +
-	"\tEventData\x12\x19\n" +
-	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1d\n" +
-	"\n" +
-	"event_type\x18\x02 \x01(\tR\teventType\x12!\n" +
-	"\fevent_detail\x18\x03 \x01(\tR\veventDetail\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"?\n" +
-	"\tStreamAck\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
-	"\x15AdminSubscribeRequest\x12\x19\n" +
-	"\badmin_id\x18\x01 \x01(\tR\aadminId\"J\n" +
-	"\x12AgentDetailRequest\x12\x19\n" +
-	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x19\n" +
-	"\bagent_id\x18\x02 \x01(\tR\aagentId2\x82\x01\n" +
-	"\fAgentService\x128\n" +
-	"\fStreamFrames\x12\x12.monitor.FrameData\x1a\x12.monitor.StreamAck(\x01\x128\n" +
-	"\fStreamEvents\x12\x12.monitor.EventData\x1a\x12.monitor.StreamAck(\x012\xe5\x01\n" +
-	"\fAdminService\x12I\n" +
-	"\x11SubscribeOverview\x12\x1e.monitor.AdminSubscribeRequest\x1a\x12.monitor.FrameData0\x01\x12D\n" +
-	"\x0fSubscribeDetail\x12\x1b.monitor.AgentDetailRequest\x1a\x12.monitor.FrameData0\x01\x12D\n" +
-	"\x0fSubscribeEvents\x12\x1b.monitor.AgentDetailRequest\x1a\x12.monitor.EventData0\x01B\bZ\x06proto/b\x06proto3"
+type OverviewFocusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var (
-	file_proto_monitor_proto_rawDescOnce sync.Once
-	file_proto_monitor_proto_rawDescData []byte
-)
+func (x *OverviewFocusResponse) Reset() {
+	*x = OverviewFocusResponse{}
+	mi := &file_proto_monitor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func file_proto_monitor_proto_rawDescGZIP() []byte {
-	file_proto_monitor_proto_rawDescOnce.Do(func() {
-		file_proto_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_monitor_proto_rawDesc), len(file_proto_monitor_proto_rawDesc)))
-	})
-	return file_proto_monitor_proto_rawDescData
+func (x *OverviewFocusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_proto_monitor_proto_goTypes = []any{
-	(*AgentInfo)(nil),             // 0: monitor.AgentInfo
-	(*AdminInfo)(nil),             // 1: monitor.AdminInfo
-	(*FrameData)(nil),             // 2: monitor.FrameData
-	(*EventData)(nil),             // 3: monitor.EventData
-	(*StreamAck)(nil),             // 4: monitor.StreamAck
-	(*AdminSubscribeRequest)(nil), // 5: monitor.AdminSubscribeRequest
-	(*AgentDetailRequest)(nil),    // 6: monitor.AgentDetailRequest
+func (*OverviewFocusResponse) ProtoMessage() {}
+
+func (x *OverviewFocusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_monitor_proto_depIdxs = []int32{
-	2, // 0: monitor.AgentService.StreamFrames:input_type -> monitor.FrameData
-	3, // 1: monitor.AgentService.StreamEvents:input_type -> monitor.EventData
-	5, // 2: monitor.AdminService.SubscribeOverview:input_type -> monitor.AdminSubscribeRequest
-	6, // 3: monitor.AdminService.SubscribeDetail:input_type -> monitor.AgentDetailRequest
-	6, // 4: monitor.AdminService.SubscribeEvents:input_type -> monitor.AgentDetailRequest
-	4, // 5: monitor.AgentService.StreamFrames:output_type -> monitor.StreamAck
-	4, // 6: monitor.AgentService.StreamEvents:output_type -> monitor.StreamAck
-	2, // 7: monitor.AdminService.SubscribeOverview:output_type -> monitor.FrameData
-	2, // 8: monitor.AdminService.SubscribeDetail:output_type -> monitor.FrameData
-	3, // 9: monitor.AdminService.SubscribeEvents:output_type -> monitor.EventData
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use OverviewFocusResponse.ProtoReflect.Descriptor instead.
+func (*OverviewFocusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{7}
 }
 
-func init() { file_proto_monitor_proto_init() }
-func file_proto_monitor_proto_init() {
-	if File_proto_monitor_proto != nil {
-		return
+type AgentAllowlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentIds      []string               `protobuf:"bytes,1,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"` // 비어 있으면 전체 Agent 허용
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentAllowlist) Reset() {
+	*x = AgentAllowlist{}
+	mi := &file_proto_monitor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentAllowlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentAllowlist) ProtoMessage() {}
+
+func (x *AgentAllowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentAllowlist.ProtoReflect.Descriptor instead.
+func (*AgentAllowlist) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AgentAllowlist) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+type ControlRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AdminId        string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // 대상 Overview 구독 ID (detail_agent_id 가 비어 있을 때)
+	DetailAgentId  string                 `protobuf:"bytes,3,opt,name=detail_agent_id,json=detailAgentId,proto3" json:"detail_agent_id,omitempty"`  // 지정 시 대상은 이 Agent 의 Detail 구독
+	RequestId      uint64                 `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`               // 응답 짝 맞춤용 (클라이언트 임의 값)
+	// Types that are valid to be assigned to Update:
+	//
+	//	*ControlRequest_Agents
+	//	*ControlRequest_MaxFps
+	//	*ControlRequest_Stride
+	//	*ControlRequest_FocusAgentId
+	//	*ControlRequest_Paused
+	Update        isControlRequest_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlRequest) Reset() {
+	*x = ControlRequest{}
+	mi := &file_proto_monitor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlRequest) ProtoMessage() {}
+
+func (x *ControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlRequest.ProtoReflect.Descriptor instead.
+func (*ControlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ControlRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ControlRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ControlRequest) GetDetailAgentId() string {
+	if x != nil {
+		return x.DetailAgentId
+	}
+	return ""
+}
+
+func (x *ControlRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ControlRequest) GetUpdate() isControlRequest_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *ControlRequest) GetAgents() *AgentAllowlist {
+	if x != nil {
+		if x, ok := x.Update.(*ControlRequest_Agents); ok {
+			return x.Agents
+		}
+	}
+	return nil
+}
+
+func (x *ControlRequest) GetMaxFps() uint32 {
+	if x != nil {
+		if x, ok := x.Update.(*ControlRequest_MaxFps); ok {
+			return x.MaxFps
+		}
+	}
+	return 0
+}
+
+func (x *ControlRequest) GetStride() uint32 {
+	if x != nil {
+		if x, ok := x.Update.(*ControlRequest_Stride); ok {
+			return x.Stride
+		}
+	}
+	return 0
+}
+
+func (x *ControlRequest) GetFocusAgentId() string {
+	if x != nil {
+		if x, ok := x.Update.(*ControlRequest_FocusAgentId); ok {
+			return x.FocusAgentId
+		}
+	}
+	return ""
+}
+
+func (x *ControlRequest) GetPaused() bool {
+	if x != nil {
+		if x, ok := x.Update.(*ControlRequest_Paused); ok {
+			return x.Paused
+		}
+	}
+	return false
+}
+
+type isControlRequest_Update interface {
+	isControlRequest_Update()
+}
+
+type ControlRequest_Agents struct {
+	Agents *AgentAllowlist `protobuf:"bytes,5,opt,name=agents,proto3,oneof"` // Overview 전용: Agent 허용 목록 교체
+}
+
+type ControlRequest_MaxFps struct {
+	MaxFps uint32 `protobuf:"varint,6,opt,name=max_fps,json=maxFps,proto3,oneof"` // Agent 별 초당 최대 전달 프레임 수 (0 이면 제한 해제)
+}
+
+type ControlRequest_Stride struct {
+	Stride uint32 `protobuf:"varint,7,opt,name=stride,proto3,oneof"` // Agent 별 N 번째 프레임마다 1개 (0, 1 이면 전체)
+}
+
+type ControlRequest_FocusAgentId struct {
+	FocusAgentId string `protobuf:"bytes,8,opt,name=focus_agent_id,json=focusAgentId,proto3,oneof"` // Overview 전용: 포커스 Agent 변경 (빈 문자열이면 해제)
+}
+
+type ControlRequest_Paused struct {
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3,oneof"` // true 면 일시정지 (그동안 프레임은 버림), false 면 재개하며 Agent 별 최신 프레임부터 전달
+}
+
+func (*ControlRequest_Agents) isControlRequest_Update() {}
+
+func (*ControlRequest_MaxFps) isControlRequest_Update() {}
+
+func (*ControlRequest_Stride) isControlRequest_Update() {}
+
+func (*ControlRequest_FocusAgentId) isControlRequest_Update() {}
+
+func (*ControlRequest_Paused) isControlRequest_Update() {}
+
+type ControlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // ok 가 false 일 때 사유 (스트림은 유지)
+	Code          uint32                 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`  // ok 가 false 일 때 gRPC 상태 코드 (NotFound, InvalidArgument 등)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlResponse) Reset() {
+	*x = ControlResponse{}
+	mi := &file_proto_monitor_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlResponse) ProtoMessage() {}
+
+func (x *ControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlResponse.ProtoReflect.Descriptor instead.
+func (*ControlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ControlResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ControlResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *ControlResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ControlResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type FrameBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frames        []*FrameData           `protobuf:"bytes,1,rep,name=frames,proto3" json:"frames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameBatch) Reset() {
+	*x = FrameBatch{}
+	mi := &file_proto_monitor_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameBatch) ProtoMessage() {}
+
+func (x *FrameBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameBatch.ProtoReflect.Descriptor instead.
+func (*FrameBatch) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FrameBatch) GetFrames() []*FrameData {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+type AgentDetailRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AdminId           string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AgentId           string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`                                         // "*" 이면 모든 Agent 수신 (SubscribeDetail 은 Agent 별 FPS 제한 적용)
+	RequireKnownAgent bool                   `protobuf:"varint,3,opt,name=require_known_agent,json=requireKnownAgent,proto3" json:"require_known_agent,omitempty"`        // true 면 서버가 본 적 없는 Agent 구독 시 NOT_FOUND 반환
+	EventTypes        []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`                                // SubscribeEvents: 비어 있으면 전체 타입 수신
+	MinSeverity       EventSeverity          `protobuf:"varint,5,opt,name=min_severity,json=minSeverity,proto3,enum=monitor.EventSeverity" json:"min_severity,omitempty"` // SubscribeEvents: 이 심각도 이상만 수신
+	SinceTimestamp    int64                  `protobuf:"varint,6,opt,name=since_timestamp,json=sinceTimestamp,proto3" json:"since_timestamp,omitempty"`                   // SubscribeDetail: 이 값 이하 타임스탬프의 프레임은 건너뜀 (0 이면 전체, 상태 신호는 항상 전달)
+	SkipPreview       bool                   `protobuf:"varint,7,opt,name=skip_preview,json=skipPreview,proto3" json:"skip_preview,omitempty"`                            // SubscribeDetail: true 면 미리보기(is_preview) 프레임 제외 (상태 신호는 항상 전달)
+	Stride            uint32                 `protobuf:"varint,8,opt,name=stride,proto3" json:"stride,omitempty"`                                                         // SubscribeDetail: Agent 별 N 번째 프레임마다 1개만 수신 (0, 1 이면 전체, FPS 제한과 함께 적용)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentDetailRequest) Reset() {
+	*x = AgentDetailRequest{}
+	mi := &file_proto_monitor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentDetailRequest) ProtoMessage() {}
+
+func (x *AgentDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentDetailRequest.ProtoReflect.Descriptor instead.
+func (*AgentDetailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AgentDetailRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *AgentDetailRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *AgentDetailRequest) GetRequireKnownAgent() bool {
+	if x != nil {
+		return x.RequireKnownAgent
+	}
+	return false
+}
+
+func (x *AgentDetailRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *AgentDetailRequest) GetMinSeverity() EventSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return EventSeverity_EVENT_SEVERITY_INFO
+}
+
+func (x *AgentDetailRequest) GetSinceTimestamp() int64 {
+	if x != nil {
+		return x.SinceTimestamp
+	}
+	return 0
+}
+
+func (x *AgentDetailRequest) GetSkipPreview() bool {
+	if x != nil {
+		return x.SkipPreview
+	}
+	return false
+}
+
+func (x *AgentDetailRequest) GetStride() uint32 {
+	if x != nil {
+		return x.Stride
+	}
+	return 0
+}
+
+type ListAgentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsRequest) Reset() {
+	*x = ListAgentsRequest{}
+	mi := &file_proto_monitor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsRequest) ProtoMessage() {}
+
+func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAgentsRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type AgentStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AgentId            string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	LastFrameTimestamp int64                  `protobuf:"varint,2,opt,name=last_frame_timestamp,json=lastFrameTimestamp,proto3" json:"last_frame_timestamp,omitempty"`                  // 마지막 프레임의 타임스탬프 (Agent 기준)
+	LastSeen           int64                  `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                                                  // 마지막 수신 시각 (서버 기준, Unix ms)
+	Offline            bool                   `protobuf:"varint,4,opt,name=offline,proto3" json:"offline,omitempty"`                                                                    // 마지막 프레임이 오프라인 신호인지 여부
+	Name               string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                                           // 운영자가 등록한 표시 이름 (없으면 빈 값)
+	Tags               map[string]string      `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 운영자가 등록한 태그 (위치 등)
+	Online             bool                   `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`                                                                      // offline 의 반대 (마지막 프레임이 오프라인 신호가 아님)
+	LastError          string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`                                                // 마지막 ERROR 상태 프레임의 사유 (없으면 빈 값)
+	Fps                float64                `protobuf:"fixed64,9,opt,name=fps,proto3" json:"fps,omitempty"`                                                                           // 서버 추정 초당 프레임 수
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AgentStatus) Reset() {
+	*x = AgentStatus{}
+	mi := &file_proto_monitor_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentStatus) ProtoMessage() {}
+
+func (x *AgentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentStatus.ProtoReflect.Descriptor instead.
+func (*AgentStatus) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AgentStatus) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *AgentStatus) GetLastFrameTimestamp() int64 {
+	if x != nil {
+		return x.LastFrameTimestamp
+	}
+	return 0
+}
+
+func (x *AgentStatus) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *AgentStatus) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *AgentStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentStatus) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AgentStatus) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *AgentStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AgentStatus) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+type ListAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agents        []*AgentStatus         `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsResponse) Reset() {
+	*x = ListAgentsResponse{}
+	mi := &file_proto_monitor_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsResponse) ProtoMessage() {}
+
+func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAgentsResponse) GetAgents() []*AgentStatus {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_proto_monitor_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{16}
+}
+
+type HealthCheckResponse struct {
+	state               protoimpl.MessageState            `protogen:"open.v1"`
+	Status              HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=monitor.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
+	OverviewSubscribers int32                             `protobuf:"varint,2,opt,name=overview_subscribers,json=overviewSubscribers,proto3" json:"overview_subscribers,omitempty"`
+	DetailSubscribers   int32                             `protobuf:"varint,3,opt,name=detail_subscribers,json=detailSubscribers,proto3" json:"detail_subscribers,omitempty"`
+	EventSubscribers    int32                             `protobuf:"varint,4,opt,name=event_subscribers,json=eventSubscribers,proto3" json:"event_subscribers,omitempty"`
+	UptimeMs            int64                             `protobuf:"varint,5,opt,name=uptime_ms,json=uptimeMs,proto3" json:"uptime_ms,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_monitor_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthCheckResponse_SERVING
+}
+
+func (x *HealthCheckResponse) GetOverviewSubscribers() int32 {
+	if x != nil {
+		return x.OverviewSubscribers
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetDetailSubscribers() int32 {
+	if x != nil {
+		return x.DetailSubscribers
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetEventSubscribers() int32 {
+	if x != nil {
+		return x.EventSubscribers
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetUptimeMs() int64 {
+	if x != nil {
+		return x.UptimeMs
+	}
+	return 0
+}
+
+var File_proto_monitor_proto protoreflect.FileDescriptor
+
+const file_proto_monitor_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/monitor.proto\x12\amonitor\"R\n" +
+	"\tAgentInfo\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"R\n" +
+	"\tAdminInfo\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"\xbb\x02\n" +
+	"\tFrameData\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1d\n" +
+	"\n" +
+	"image_data\x18\x02 \x01(\fR\timageData\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"is_preview\x18\x04 \x01(\bR\tisPreview\x12\x18\n" +
+	"\aoffline\x18\x05 \x01(\bR\aoffline\x12,\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x14.monitor.FrameStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\a \x01(\tR\rstatusMessage\x12\x1a\n" +
+	"\bsequence\x18\b \x01(\x04R\bsequence\x12,\n" +
+	"\x06format\x18\t \x01(\x0e2\x14.monitor.ImageFormatR\x06format\"\xba\x01\n" +
+	"\tEventData\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12!\n" +
+	"\fevent_detail\x18\x03 \x01(\tR\veventDetail\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x122\n" +
+	"\bseverity\x18\x05 \x01(\x0e2\x16.monitor.EventSeverityR\bseverity\"?\n" +
+	"\tStreamAck\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe2\x02\n" +
+	"\x15AdminSubscribeRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x1b\n" +
+	"\tagent_ids\x18\x02 \x03(\tR\bagentIds\x12'\n" +
+	"\x0fsubscription_id\x18\x03 \x01(\tR\x0esubscriptionId\x12(\n" +
+	"\x10batch_max_frames\x18\x04 \x01(\rR\x0ebatchMaxFrames\x12+\n" +
+	"\x12batch_max_delay_ms\x18\x05 \x01(\rR\x0fbatchMaxDelayMs\x12#\n" +
+	"\rpreviews_only\x18\x06 \x01(\bR\fpreviewsOnly\x12\x16\n" +
+	"\x06stride\x18\a \x01(\rR\x06stride\x12\x16\n" +
+	"\x06mosaic\x18\b \x01(\bR\x06mosaic\x12$\n" +
+	"\x0efocus_agent_id\x18\t \x01(\tR\ffocusAgentId\x12\x16\n" +
+	"\x06paused\x18\n" +
+	" \x01(\bR\x06paused\"\x80\x01\n" +
+	"\x14OverviewFocusRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12$\n" +
+	"\x0efocus_agent_id\x18\x03 \x01(\tR\ffocusAgentId\"\x17\n" +
+	"\x15OverviewFocusResponse\"-\n" +
+	"\x0eAgentAllowlist\x12\x1b\n" +
+	"\tagent_ids\x18\x01 \x03(\tR\bagentIds\"\xcf\x02\n" +
+	"\x0eControlRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12&\n" +
+	"\x0fdetail_agent_id\x18\x03 \x01(\tR\rdetailAgentId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\x04R\trequestId\x121\n" +
+	"\x06agents\x18\x05 \x01(\v2\x17.monitor.AgentAllowlistH\x00R\x06agents\x12\x19\n" +
+	"\amax_fps\x18\x06 \x01(\rH\x00R\x06maxFps\x12\x18\n" +
+	"\x06stride\x18\a \x01(\rH\x00R\x06stride\x12&\n" +
+	"\x0efocus_agent_id\x18\b \x01(\tH\x00R\ffocusAgentId\x12\x18\n" +
+	"\x06paused\x18\t \x01(\bH\x00R\x06pausedB\b\n" +
+	"\x06update\"j\n" +
+	"\x0fControlResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\rR\x04code\"8\n" +
+	"\n" +
+	"FrameBatch\x12*\n" +
+	"\x06frames\x18\x01 \x03(\v2\x12.monitor.FrameDataR\x06frames\"\xba\x02\n" +
+	"\x12AgentDetailRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12.\n" +
+	"\x13require_known_agent\x18\x03 \x01(\bR\x11requireKnownAgent\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x129\n" +
+	"\fmin_severity\x18\x05 \x01(\x0e2\x16.monitor.EventSeverityR\vminSeverity\x12'\n" +
+	"\x0fsince_timestamp\x18\x06 \x01(\x03R\x0esinceTimestamp\x12!\n" +
+	"\fskip_preview\x18\a \x01(\bR\vskipPreview\x12\x16\n" +
+	"\x06stride\x18\b \x01(\rR\x06stride\".\n" +
+	"\x11ListAgentsRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\"\xdb\x02\n" +
+	"\vAgentStatus\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x120\n" +
+	"\x14last_frame_timestamp\x18\x02 \x01(\x03R\x12lastFrameTimestamp\x12\x1b\n" +
+	"\tlast_seen\x18\x03 \x01(\x03R\blastSeen\x12\x18\n" +
+	"\aoffline\x18\x04 \x01(\bR\aoffline\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x122\n" +
+	"\x04tags\x18\x06 \x03(\v2\x1e.monitor.AgentStatus.TagsEntryR\x04tags\x12\x16\n" +
+	"\x06online\x18\a \x01(\bR\x06online\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12\x10\n" +
+	"\x03fps\x18\t \x01(\x01R\x03fps\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\x12ListAgentsResponse\x12,\n" +
+	"\x06agents\x18\x01 \x03(\v2\x14.monitor.AgentStatusR\x06agents\"\x14\n" +
+	"\x12HealthCheckRequest\"\xb4\x02\n" +
+	"\x13HealthCheckResponse\x12B\n" +
+	"\x06status\x18\x01 \x01(\x0e2*.monitor.HealthCheckResponse.ServingStatusR\x06status\x121\n" +
+	"\x14overview_subscribers\x18\x02 \x01(\x05R\x13overviewSubscribers\x12-\n" +
+	"\x12detail_subscribers\x18\x03 \x01(\x05R\x11detailSubscribers\x12+\n" +
+	"\x11event_subscribers\x18\x04 \x01(\x05R\x10eventSubscribers\x12\x1b\n" +
+	"\tuptime_ms\x18\x05 \x01(\x03R\buptimeMs\"-\n" +
+	"\rServingStatus\x12\v\n" +
+	"\aSERVING\x10\x00\x12\x0f\n" +
+	"\vNOT_SERVING\x10\x01*V\n" +
+	"\vFrameStatus\x12\x15\n" +
+	"\x11FRAME_STATUS_LIVE\x10\x00\x12\x18\n" +
+	"\x14FRAME_STATUS_OFFLINE\x10\x01\x12\x16\n" +
+	"\x12FRAME_STATUS_ERROR\x10\x02*\x89\x01\n" +
+	"\vImageFormat\x12\x1c\n" +
+	"\x18IMAGE_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14IMAGE_FORMAT_UNKNOWN\x10\x01\x12\x15\n" +
+	"\x11IMAGE_FORMAT_JPEG\x10\x02\x12\x14\n" +
+	"\x10IMAGE_FORMAT_PNG\x10\x03\x12\x15\n" +
+	"\x11IMAGE_FORMAT_WEBP\x10\x04*^\n" +
+	"\rEventSeverity\x12\x17\n" +
+	"\x13EVENT_SEVERITY_INFO\x10\x00\x12\x1a\n" +
+	"\x16EVENT_SEVERITY_WARNING\x10\x01\x12\x18\n" +
+	"\x14EVENT_SEVERITY_ERROR\x10\x022\x82\x01\n" +
+	"\fAgentService\x128\n" +
+	"\fStreamFrames\x12\x12.monitor.FrameData\x1a\x12.monitor.StreamAck(\x01\x128\n" +
+	"\fStreamEvents\x12\x12.monitor.EventData\x1a\x12.monitor.StreamAck(\x012\xdc\x04\n" +
+	"\fAdminService\x12I\n" +
+	"\x11SubscribeOverview\x12\x1e.monitor.AdminSubscribeRequest\x1a\x12.monitor.FrameData0\x01\x12O\n" +
+	"\x16SubscribeOverviewBatch\x12\x1e.monitor.AdminSubscribeRequest\x1a\x13.monitor.FrameBatch0\x01\x12Q\n" +
+	"\x10SetOverviewFocus\x12\x1d.monitor.OverviewFocusRequest\x1a\x1e.monitor.OverviewFocusResponse\x12@\n" +
+	"\aControl\x12\x17.monitor.ControlRequest\x1a\x18.monitor.ControlResponse(\x010\x01\x12D\n" +
+	"\x0fSubscribeDetail\x12\x1b.monitor.AgentDetailRequest\x1a\x12.monitor.FrameData0\x01\x12D\n" +
+	"\x0fSubscribeEvents\x12\x1b.monitor.AgentDetailRequest\x1a\x12.monitor.EventData0\x01\x12E\n" +
+	"\n" +
+	"ListAgents\x12\x1a.monitor.ListAgentsRequest\x1a\x1b.monitor.ListAgentsResponse\x12H\n" +
+	"\vHealthCheck\x12\x1b.monitor.HealthCheckRequest\x1a\x1c.monitor.HealthCheckResponseB\bZ\x06proto/b\x06proto3"
+
+var (
+	file_proto_monitor_proto_rawDescOnce sync.Once
+	file_proto_monitor_proto_rawDescData []byte
+)
+
+func file_proto_monitor_proto_rawDescGZIP() []byte {
+	file_proto_monitor_proto_rawDescOnce.Do(func() {
+		file_proto_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_monitor_proto_rawDesc), len(file_proto_monitor_proto_rawDesc)))
+	})
+	return file_proto_monitor_proto_rawDescData
+}
+
+var file_proto_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_monitor_proto_goTypes = []any{
+	(FrameStatus)(0),                       // 0: monitor.FrameStatus
+	(ImageFormat)(0),                       // 1: monitor.ImageFormat
+	(EventSeverity)(0),                     // 2: monitor.EventSeverity
+	(HealthCheckResponse_ServingStatus)(0), // 3: monitor.HealthCheckResponse.ServingStatus
+	(*AgentInfo)(nil),                      // 4: monitor.AgentInfo
+	(*AdminInfo)(nil),                      // 5: monitor.AdminInfo
+	(*FrameData)(nil),                      // 6: monitor.FrameData
+	(*EventData)(nil),                      // 7: monitor.EventData
+	(*StreamAck)(nil),                      // 8: monitor.StreamAck
+	(*AdminSubscribeRequest)(nil),          // 9: monitor.AdminSubscribeRequest
+	(*OverviewFocusRequest)(nil),           // 10: monitor.OverviewFocusRequest
+	(*OverviewFocusResponse)(nil),          // 11: monitor.OverviewFocusResponse
+	(*AgentAllowlist)(nil),                 // 12: monitor.AgentAllowlist
+	(*ControlRequest)(nil),                 // 13: monitor.ControlRequest
+	(*ControlResponse)(nil),                // 14: monitor.ControlResponse
+	(*FrameBatch)(nil),                     // 15: monitor.FrameBatch
+	(*AgentDetailRequest)(nil),             // 16: monitor.AgentDetailRequest
+	(*ListAgentsRequest)(nil),              // 17: monitor.ListAgentsRequest
+	(*AgentStatus)(nil),                    // 18: monitor.AgentStatus
+	(*ListAgentsResponse)(nil),             // 19: monitor.ListAgentsResponse
+	(*HealthCheckRequest)(nil),             // 20: monitor.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 21: monitor.HealthCheckResponse
+	nil,                                    // 22: monitor.AgentStatus.TagsEntry
+}
+var file_proto_monitor_proto_depIdxs = []int32{
+	0,  // 0: monitor.FrameData.status:type_name -> monitor.FrameStatus
+	1,  // 1: monitor.FrameData.format:type_name -> monitor.ImageFormat
+	2,  // 2: monitor.EventData.severity:type_name -> monitor.EventSeverity
+	12, // 3: monitor.ControlRequest.agents:type_name -> monitor.AgentAllowlist
+	6,  // 4: monitor.FrameBatch.frames:type_name -> monitor.FrameData
+	2,  // 5: monitor.AgentDetailRequest.min_severity:type_name -> monitor.EventSeverity
+	22, // 6: monitor.AgentStatus.tags:type_name -> monitor.AgentStatus.TagsEntry
+	18, // 7: monitor.ListAgentsResponse.agents:type_name -> monitor.AgentStatus
+	3,  // 8: monitor.HealthCheckResponse.status:type_name -> monitor.HealthCheckResponse.ServingStatus
+	6,  // 9: monitor.AgentService.StreamFrames:input_type -> monitor.FrameData
+	7,  // 10: monitor.AgentService.StreamEvents:input_type -> monitor.EventData
+	9,  // 11: monitor.AdminService.SubscribeOverview:input_type -> monitor.AdminSubscribeRequest
+	9,  // 12: monitor.AdminService.SubscribeOverviewBatch:input_type -> monitor.AdminSubscribeRequest
+	10, // 13: monitor.AdminService.SetOverviewFocus:input_type -> monitor.OverviewFocusRequest
+	13, // 14: monitor.AdminService.Control:input_type -> monitor.ControlRequest
+	16, // 15: monitor.AdminService.SubscribeDetail:input_type -> monitor.AgentDetailRequest
+	16, // 16: monitor.AdminService.SubscribeEvents:input_type -> monitor.AgentDetailRequest
+	17, // 17: monitor.AdminService.ListAgents:input_type -> monitor.ListAgentsRequest
+	20, // 18: monitor.AdminService.HealthCheck:input_type -> monitor.HealthCheckRequest
+	8,  // 19: monitor.AgentService.StreamFrames:output_type -> monitor.StreamAck
+	8,  // 20: monitor.AgentService.StreamEvents:output_type -> monitor.StreamAck
+	6,  // 21: monitor.AdminService.SubscribeOverview:output_type -> monitor.FrameData
+	15, // 22: monitor.AdminService.SubscribeOverviewBatch:output_type -> monitor.FrameBatch
+	11, // 23: monitor.AdminService.SetOverviewFocus:output_type -> monitor.OverviewFocusResponse
+	14, // 24: monitor.AdminService.Control:output_type -> monitor.ControlResponse
+	6,  // 25: monitor.AdminService.SubscribeDetail:output_type -> monitor.FrameData
+	7,  // 26: monitor.AdminService.SubscribeEvents:output_type -> monitor.EventData
+	19, // 27: monitor.AdminService.ListAgents:output_type -> monitor.ListAgentsResponse
+	21, // 28: monitor.AdminService.HealthCheck:output_type -> monitor.HealthCheckResponse
+	19, // [19:29] is the sub-list for method output_type
+	9,  // [9:19] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_monitor_proto_init() }
+func file_proto_monitor_proto_init() {
+	if File_proto_monitor_proto != nil {
+		return
+	}
+	file_proto_monitor_proto_msgTypes[9].OneofWrappers = []any{
+		(*ControlRequest_Agents)(nil),
+		(*ControlRequest_MaxFps)(nil),
+		(*ControlRequest_Stride)(nil),
+		(*ControlRequest_FocusAgentId)(nil),
+		(*ControlRequest_Paused)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_monitor_proto_rawDesc), len(file_proto_monitor_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_proto_monitor_proto_goTypes,
 		DependencyIndexes: file_proto_monitor_proto_depIdxs,
+		EnumInfos:         file_proto_monitor_proto_enumTypes,
 		MessageInfos:      file_proto_monitor_proto_msgTypes,
 	}.Build()
 	File_proto_monitor_proto = out.File
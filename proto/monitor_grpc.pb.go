@@ -153,9 +153,14 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AdminService_SubscribeOverview_FullMethodName = "/monitor.AdminService/SubscribeOverview"
-	AdminService_SubscribeDetail_FullMethodName   = "/monitor.AdminService/SubscribeDetail"
-	AdminService_SubscribeEvents_FullMethodName   = "/monitor.AdminService/SubscribeEvents"
+	AdminService_SubscribeOverview_FullMethodName      = "/monitor.AdminService/SubscribeOverview"
+	AdminService_SubscribeOverviewBatch_FullMethodName = "/monitor.AdminService/SubscribeOverviewBatch"
+	AdminService_SetOverviewFocus_FullMethodName       = "/monitor.AdminService/SetOverviewFocus"
+	AdminService_Control_FullMethodName                = "/monitor.AdminService/Control"
+	AdminService_SubscribeDetail_FullMethodName        = "/monitor.AdminService/SubscribeDetail"
+	AdminService_SubscribeEvents_FullMethodName        = "/monitor.AdminService/SubscribeEvents"
+	AdminService_ListAgents_FullMethodName             = "/monitor.AdminService/ListAgents"
+	AdminService_HealthCheck_FullMethodName            = "/monitor.AdminService/HealthCheck"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -166,10 +171,20 @@ const (
 type AdminServiceClient interface {
 	// 전체 Agent 목록과 미리보기 프레임 실시간 수신
 	SubscribeOverview(ctx context.Context, in *AdminSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FrameData], error)
+	// Overview 프레임을 묶음으로 수신 (전송 횟수 절감용, 구버전 서버는 UNIMPLEMENTED)
+	SubscribeOverviewBatch(ctx context.Context, in *AdminSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FrameBatch], error)
+	// 실행 중인 Overview 구독의 포커스 Agent 변경 (별도 Detail 스트림 없이 한 Agent 만 원본 해상도로 수신)
+	SetOverviewFocus(ctx context.Context, in *OverviewFocusRequest, opts ...grpc.CallOption) (*OverviewFocusResponse, error)
+	// 실행 중인 구독의 필터/속도/포커스를 재연결 없이 변경 (요청마다 ControlResponse 1개)
+	Control(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlRequest, ControlResponse], error)
 	// 특정 Agent의 상세 화면 실시간 수신
 	SubscribeDetail(ctx context.Context, in *AgentDetailRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FrameData], error)
 	// 특정 Agent의 이벤트 로그 실시간 수신
 	SubscribeEvents(ctx context.Context, in *AgentDetailRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventData], error)
+	// 서버가 알고 있는 Agent 목록과 마지막 수신 시각 조회
+	ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error)
+	// 서버 상태 확인 (liveness/readiness probe, 구독 전 사전 확인용)
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
 type adminServiceClient struct {
@@ -199,9 +214,51 @@ func (c *adminServiceClient) SubscribeOverview(ctx context.Context, in *AdminSub
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AdminService_SubscribeOverviewClient = grpc.ServerStreamingClient[FrameData]
 
+func (c *adminServiceClient) SubscribeOverviewBatch(ctx context.Context, in *AdminSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FrameBatch], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_SubscribeOverviewBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AdminSubscribeRequest, FrameBatch]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_SubscribeOverviewBatchClient = grpc.ServerStreamingClient[FrameBatch]
+
+func (c *adminServiceClient) SetOverviewFocus(ctx context.Context, in *OverviewFocusRequest, opts ...grpc.CallOption) (*OverviewFocusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OverviewFocusResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOverviewFocus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Control(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlRequest, ControlResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[2], AdminService_Control_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ControlRequest, ControlResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ControlClient = grpc.BidiStreamingClient[ControlRequest, ControlResponse]
+
 func (c *adminServiceClient) SubscribeDetail(ctx context.Context, in *AgentDetailRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FrameData], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_SubscribeDetail_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[3], AdminService_SubscribeDetail_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +277,7 @@ type AdminService_SubscribeDetailClient = grpc.ServerStreamingClient[FrameData]
 
 func (c *adminServiceClient) SubscribeEvents(ctx context.Context, in *AgentDetailRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventData], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[2], AdminService_SubscribeEvents_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[4], AdminService_SubscribeEvents_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +294,26 @@ func (c *adminServiceClient) SubscribeEvents(ctx context.Context, in *AgentDetai
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AdminService_SubscribeEventsClient = grpc.ServerStreamingClient[EventData]
 
+func (c *adminServiceClient) ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAgents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -245,10 +322,20 @@ type AdminService_SubscribeEventsClient = grpc.ServerStreamingClient[EventData]
 type AdminServiceServer interface {
 	// 전체 Agent 목록과 미리보기 프레임 실시간 수신
 	SubscribeOverview(*AdminSubscribeRequest, grpc.ServerStreamingServer[FrameData]) error
+	// Overview 프레임을 묶음으로 수신 (전송 횟수 절감용, 구버전 서버는 UNIMPLEMENTED)
+	SubscribeOverviewBatch(*AdminSubscribeRequest, grpc.ServerStreamingServer[FrameBatch]) error
+	// 실행 중인 Overview 구독의 포커스 Agent 변경 (별도 Detail 스트림 없이 한 Agent 만 원본 해상도로 수신)
+	SetOverviewFocus(context.Context, *OverviewFocusRequest) (*OverviewFocusResponse, error)
+	// 실행 중인 구독의 필터/속도/포커스를 재연결 없이 변경 (요청마다 ControlResponse 1개)
+	Control(grpc.BidiStreamingServer[ControlRequest, ControlResponse]) error
 	// 특정 Agent의 상세 화면 실시간 수신
 	SubscribeDetail(*AgentDetailRequest, grpc.ServerStreamingServer[FrameData]) error
 	// 특정 Agent의 이벤트 로그 실시간 수신
 	SubscribeEvents(*AgentDetailRequest, grpc.ServerStreamingServer[EventData]) error
+	// 서버가 알고 있는 Agent 목록과 마지막 수신 시각 조회
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	// 서버 상태 확인 (liveness/readiness probe, 구독 전 사전 확인용)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -262,12 +349,27 @@ type UnimplementedAdminServiceServer struct{}
 func (UnimplementedAdminServiceServer) SubscribeOverview(*AdminSubscribeRequest, grpc.ServerStreamingServer[FrameData]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeOverview not implemented")
 }
+func (UnimplementedAdminServiceServer) SubscribeOverviewBatch(*AdminSubscribeRequest, grpc.ServerStreamingServer[FrameBatch]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOverviewBatch not implemented")
+}
+func (UnimplementedAdminServiceServer) SetOverviewFocus(context.Context, *OverviewFocusRequest) (*OverviewFocusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOverviewFocus not implemented")
+}
+func (UnimplementedAdminServiceServer) Control(grpc.BidiStreamingServer[ControlRequest, ControlResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Control not implemented")
+}
 func (UnimplementedAdminServiceServer) SubscribeDetail(*AgentDetailRequest, grpc.ServerStreamingServer[FrameData]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeDetail not implemented")
 }
 func (UnimplementedAdminServiceServer) SubscribeEvents(*AgentDetailRequest, grpc.ServerStreamingServer[EventData]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
 }
+func (UnimplementedAdminServiceServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedAdminServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -300,6 +402,42 @@ func _AdminService_SubscribeOverview_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AdminService_SubscribeOverviewServer = grpc.ServerStreamingServer[FrameData]
 
+func _AdminService_SubscribeOverviewBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AdminSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).SubscribeOverviewBatch(m, &grpc.GenericServerStream[AdminSubscribeRequest, FrameBatch]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_SubscribeOverviewBatchServer = grpc.ServerStreamingServer[FrameBatch]
+
+func _AdminService_SetOverviewFocus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverviewFocusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetOverviewFocus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetOverviewFocus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetOverviewFocus(ctx, req.(*OverviewFocusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Control_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).Control(&grpc.GenericServerStream[ControlRequest, ControlResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ControlServer = grpc.BidiStreamingServer[ControlRequest, ControlResponse]
+
 func _AdminService_SubscribeDetail_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(AgentDetailRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -322,19 +460,79 @@ func _AdminService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AdminService_SubscribeEventsServer = grpc.ServerStreamingServer[EventData]
 
+func _AdminService_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAgents(ctx, req.(*ListAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var AdminService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "monitor.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetOverviewFocus",
+			Handler:    _AdminService_SetOverviewFocus_Handler,
+		},
+		{
+			MethodName: "ListAgents",
+			Handler:    _AdminService_ListAgents_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _AdminService_HealthCheck_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "SubscribeOverview",
 			Handler:       _AdminService_SubscribeOverview_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOverviewBatch",
+			Handler:       _AdminService_SubscribeOverviewBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Control",
+			Handler:       _AdminService_Control_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SubscribeDetail",
 			Handler:       _AdminService_SubscribeDetail_Handler,
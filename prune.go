@@ -0,0 +1,53 @@
+package main
+
+// 프레임 캐시 정리
+// - 오프라인 후 복귀하지 않는 Agent 의 스냅샷이 latestFrames 에 계속 남지 않도록 오래된 항목 제거
+// - 같은 framesMu 구간에서 해당 Agent 의 frameStats 와 대기 중인 오프라인 타이머도 함께 정리
+//   (정리된 Agent 에 늦게 오프라인이 발행되거나 Agent 교체가 잦을 때 메모리가 계속 늘지 않도록)
+// - 프론트에서 PruneStaleFrames 를 직접 호출하거나, pruneLoop 가 주기적으로 실행
+
+import (
+	"log"
+	"time"
+)
+
+const (
+	// 주기적 캐시 정리 간격
+	STALE_FRAME_PRUNE_INTERVAL_MS = 60 * 1000
+	// 이 시간 이상 새 프레임이 없는 스냅샷은 정리 대상
+	STALE_FRAME_MAX_AGE_MS = 10 * 60 * 1000
+)
+
+// PruneStaleFrames 마지막 수신 후 maxAge 가 지난 스냅샷을 캐시에서 제거하고 제거 개수를 반환합니다.
+// 오프라인 스냅샷은 오프라인 신호 수신 시각이 기준이므로, 복귀하지 않으면 maxAge 후 제거됩니다.
+func (a *App) PruneStaleFrames(maxAge time.Duration) int {
+	cutoff := time.Now().Add(-maxAge).UnixMilli()
+	a.framesMu.Lock()
+	defer a.framesMu.Unlock()
+	pruned := 0
+	for agentId, snap := range a.latestFrames {
+		if snap.ReceivedAt < cutoff {
+			delete(a.latestFrames, agentId)
+			delete(a.frameStats, agentId)
+			a.cancelOfflineLocked(agentId)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// pruneLoop 주기적으로 오래된 스냅샷을 정리합니다.
+func (a *App) pruneLoop() {
+	ticker := time.NewTicker(STALE_FRAME_PRUNE_INTERVAL_MS * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			if n := a.PruneStaleFrames(STALE_FRAME_MAX_AGE_MS * time.Millisecond); n > 0 {
+				log.Printf("[Admin][CACHE] 오래된 스냅샷 %d 개 정리", n)
+			}
+		}
+	}
+}
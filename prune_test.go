@@ -0,0 +1,41 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestPruneStaleFramesKeepsFresh(t *testing.T) {
+	app, _ := newTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "stale", ImageData: []byte("old")})
+	storeTestFrame(app, &proto.FrameData{AgentId: "fresh", ImageData: []byte("new")})
+	// stale 은 한 시간 전에 받은 것으로 만들고 대기 중인 오프라인 처리도 걸어 둠
+	app.SetOfflineGrace(int(time.Hour.Milliseconds()))
+	app.handleOfflineFrame(&proto.FrameData{AgentId: "stale", Offline: true}, app.serverAddress())
+	app.framesMu.Lock()
+	app.latestFrames["stale"].ReceivedAt = time.Now().Add(-time.Hour).UnixMilli()
+	app.framesMu.Unlock()
+
+	if n := app.PruneStaleFrames(time.Minute); n != 1 {
+		t.Fatalf("PruneStaleFrames = %d, want 1", n)
+	}
+	if _, ok := app.GetLatestFrame("stale"); ok {
+		t.Fatal("오래된 스냅샷이 남아 있음")
+	}
+	if _, ok := app.GetLatestFrame("fresh"); !ok {
+		t.Fatal("최근 스냅샷이 정리됨")
+	}
+	app.framesMu.RLock()
+	defer app.framesMu.RUnlock()
+	if _, ok := app.frameStats["stale"]; ok {
+		t.Fatal("정리된 Agent 의 frameStats 가 남아 있음")
+	}
+	if _, ok := app.offlineTimers["stale"]; ok {
+		t.Fatal("정리된 Agent 의 오프라인 타이머가 남아 있음")
+	}
+	if _, ok := app.frameStats["fresh"]; !ok {
+		t.Fatal("최근 Agent 의 frameStats 가 정리됨")
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+// Overview 프레임 raw 전송 모드
+// - 기본(base64): Go 에서 EncodeToString 한 문자열을 imageBase64 로 발행 (기존 프론트 호환)
+// - raw: 이벤트에는 이미지 대신 imageUrl 만 싣고, 바이트는 Wails AssetServer 핸들러가 HTTP 응답 본문으로 그대로 전달
+//   (이벤트 브리지는 JSON 직렬화라 []byte 를 실으면 결국 base64 가 되므로, 바이너리 경로를 따로 둠)
+// - imageUrl: FRAME_IMAGE_PATH + agentId, 쿼리 server(출처 서버) / v(sequence, 없으면 timestamp - 캐시 무효화용)
+// - 핸들러는 요청 시점의 최신 스냅샷을 돌려줌 (v 보다 새 프레임이 도착했으면 새 프레임)
+// - frameSnapshot: base64 경로는 ImageBase, raw 경로는 ImageBytes(JSON 제외) + ImageURL 을 채우고 Encoding 으로 구분
+
+import (
+	"encoding/base64"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"admin/proto"
+)
+
+const (
+	// 프레임 이미지 전달 방식 (payload / frameSnapshot 의 encoding 값)
+	FRAME_ENCODING_BASE64 = "base64"
+	FRAME_ENCODING_RAW    = "raw"
+	// raw 모드 프레임 이미지를 제공하는 AssetServer 경로 접두사
+	FRAME_IMAGE_PATH = "/frames/"
+)
+
+// SetRawFrameEmit Overview 프레임을 base64 문자열 대신 raw 바이트(imageUrl)로 전달할지 설정합니다.
+// 이미 캐시된 프레임은 그대로 두고 이후 수신분부터 적용됩니다.
+func (a *App) SetRawFrameEmit(enabled bool) {
+	a.rawFrameEmit.Store(enabled)
+	log.Printf("[Admin][STREAM] raw frame emit: %v", enabled)
+}
+
+// IsRawFrameEmit raw 바이트 전달 모드 여부를 반환합니다.
+func (a *App) IsRawFrameEmit() bool {
+	return a.rawFrameEmit.Load()
+}
+
+// frameImageURL raw 모드 프레임 이미지 주소를 만듭니다.
+func frameImageURL(frame *proto.FrameData, server string) string {
+	version := strconv.FormatUint(frame.GetSequence(), 10)
+	if frame.GetSequence() == 0 {
+		version = strconv.FormatInt(frame.GetTimestamp(), 10)
+	}
+	query := url.Values{}
+	query.Set("server", server)
+	query.Set("v", version)
+	return FRAME_IMAGE_PATH + url.PathEscape(frame.GetAgentId()) + "?" + query.Encode()
+}
+
+// newRawFrameSnapshot raw 모드 캐시 스냅샷을 만듭니다. (base64 인코딩 없음)
+func newRawFrameSnapshot(frame *proto.FrameData, server string) *frameSnapshot {
+	snap := newFrameSnapshot(frame, "", server)
+	snap.ImageBytes = frame.GetImageData()
+	snap.ImageURL = frameImageURL(frame, server)
+	snap.Encoding = FRAME_ENCODING_RAW
+	return snap
+}
+
+// rawFrameEventPayload raw 모드용 프레임 이벤트 payload 를 만듭니다. (imageBase64 는 비우고 imageUrl 전달)
+func rawFrameEventPayload(frame *proto.FrameData, server string) map[string]any {
+	payload := frameEventPayload(frame, "", server)
+	payload["imageUrl"] = frameImageURL(frame, server)
+	payload["encoding"] = FRAME_ENCODING_RAW
+	return payload
+}
+
+// frameImageBytes 스냅샷의 이미지 바이트를 반환합니다. (raw 는 그대로, base64 는 디코딩)
+func frameImageBytes(snap frameSnapshot) ([]byte, error) {
+	if snap.Encoding == FRAME_ENCODING_RAW {
+		return snap.ImageBytes, nil
+	}
+	return base64.StdEncoding.DecodeString(snap.ImageBase)
+}
+
+// frameImageHandler는 raw 모드 프레임 이미지를 제공하는 AssetServer 핸들러입니다.
+type frameImageHandler struct {
+	app *App
+}
+
+// frameImageHandler AssetServer.Handler 로 등록할 핸들러를 반환합니다.
+func (a *App) frameImageHandler() http.Handler {
+	return frameImageHandler{app: a}
+}
+
+// ServeHTTP FRAME_IMAGE_PATH 요청에 해당 Agent 의 최신 이미지 바이트를 응답합니다.
+func (h frameImageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, FRAME_IMAGE_PATH) {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	agentId, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), FRAME_IMAGE_PATH))
+	if err != nil || agentId == "" {
+		http.NotFound(w, r)
+		return
+	}
+	snap, ok := h.app.lookupSnapshot(r.URL.Query().Get("server"), agentId)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	data, err := frameImageBytes(snap)
+	if err != nil || len(data) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", http.DetectContentType(data))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// lookupSnapshot 서버 주소와 agentId 로 캐시 스냅샷을 찾습니다. server 가 비었거나 기본 서버면 기본 캐시를 봅니다.
+func (a *App) lookupSnapshot(server, agentId string) (frameSnapshot, bool) {
+	if server == "" || server == a.serverAddress() {
+		a.framesMu.RLock()
+		defer a.framesMu.RUnlock()
+		if snap, ok := a.latestFrames[agentId]; ok {
+			return *snap, true
+		}
+		return frameSnapshot{}, false
+	}
+	a.serversMu.Lock()
+	sc, ok := a.servers[server]
+	a.serversMu.Unlock()
+	if !ok {
+		return frameSnapshot{}, false
+	}
+	sc.framesMu.RLock()
+	defer sc.framesMu.RUnlock()
+	if snap, ok := sc.latestFrames[agentId]; ok {
+		return *snap, true
+	}
+	return frameSnapshot{}, false
+}
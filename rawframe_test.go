@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"admin/proto"
+)
+
+// processTestFrame 발행 속도 제한 없이 Overview 프레임 하나를 처리한 App 과 발행된 payload 를 반환합니다.
+func processTestFrame(t *testing.T, raw bool, frame *proto.FrameData) (*App, map[string]any) {
+	t.Helper()
+	app, rec := newTestApp()
+	app.SetOverviewEmitRate(0)
+	app.SetRawFrameEmit(raw)
+	app.processOverviewFrame(encodeJob{frame: frame, server: app.serverAddress()})
+	events := rec.named(EVENT_OVERVIEW_FRAME)
+	if len(events) != 1 {
+		t.Fatalf("Overview 이벤트 = %d 개, want 1", len(events))
+	}
+	return app, events[0].(map[string]any)
+}
+
+func TestRawFrameEmitServesBytes(t *testing.T) {
+	image := bytes.Repeat([]byte{0xff, 0xd8, 0xff}, 1000)
+	app, payload := processTestFrame(t, true, &proto.FrameData{AgentId: "agent 1", ImageData: image, Timestamp: 1000, Sequence: 5})
+
+	if payload["encoding"] != FRAME_ENCODING_RAW || payload["imageBase64"] != "" {
+		t.Fatalf("raw payload = encoding %v imageBase64 %q", payload["encoding"], payload["imageBase64"])
+	}
+	imageURL, _ := payload["imageUrl"].(string)
+	if imageURL == "" {
+		t.Fatal("raw payload 에 imageUrl 없음")
+	}
+
+	rr := httptest.NewRecorder()
+	app.frameImageHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, imageURL, nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("이미지 응답 코드 = %d, want 200", rr.Code)
+	}
+	if rr.Body.Len() != len(image) || !bytes.Equal(rr.Body.Bytes(), image) {
+		t.Fatalf("이미지 응답 길이 = %d, want %d", rr.Body.Len(), len(image))
+	}
+	snap, _ := app.GetLatestFrame("agent 1")
+	if snap.Encoding != FRAME_ENCODING_RAW || len(snap.ImageBytes) != len(image) || snap.ImageBase != "" {
+		t.Fatalf("raw 스냅샷 = encoding %q bytes %d base64 %d", snap.Encoding, len(snap.ImageBytes), len(snap.ImageBase))
+	}
+}
+
+func TestBase64FrameEmitIsDefault(t *testing.T) {
+	app, _ := newTestApp()
+	if app.IsRawFrameEmit() {
+		t.Fatal("기본 전달 방식이 raw")
+	}
+	_, payload := processTestFrame(t, false, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: 1000})
+	if payload["encoding"] != FRAME_ENCODING_BASE64 || payload["imageBase64"] != "aW1n" {
+		t.Fatalf("base64 payload = encoding %v imageBase64 %v", payload["encoding"], payload["imageBase64"])
+	}
+}
+
+func TestFrameImageHandlerNotFound(t *testing.T) {
+	app, _ := newTestApp()
+	rr := httptest.NewRecorder()
+	app.frameImageHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, FRAME_IMAGE_PATH+"missing", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("없는 Agent 응답 코드 = %d, want 404", rr.Code)
+	}
+}
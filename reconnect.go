@@ -0,0 +1,93 @@
+package main
+
+// 재연결 정책 (대기 범위 / 최대 재시도)
+// - ADMIN_RECONNECT_MIN_MS / ADMIN_RECONNECT_MAX_MS 로 백오프 대기 하한/상한 변경 (기본 RECONNECT_BACKOFF_MIN_MS / MAX_MS)
+// - ADMIN_RECONNECT_MAX_RETRIES 를 넘겨 연속 실패하면 bootstrapLoop 를 멈추고 terminal=true 인 disconnected 상태 발행
+// - 기본값 0 은 무제한 재시도 (기존 동작)
+// - 포기 후에는 Reconnect() 로 백오프를 초기화하고 연결 루프를 다시 시작
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// 재연결 정책 환경변수 이름
+	ENV_RECONNECT_MIN_MS      = "ADMIN_RECONNECT_MIN_MS"
+	ENV_RECONNECT_MAX_MS      = "ADMIN_RECONNECT_MAX_MS"
+	ENV_RECONNECT_MAX_RETRIES = "ADMIN_RECONNECT_MAX_RETRIES"
+)
+
+// envInt 양의 정수 환경변수를 읽습니다. 미설정/잘못된 값이면 def 를 반환합니다.
+func envInt(name string, def int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		log.Printf("[Admin][BOOT] %s 값 무시: %q", name, raw)
+		return def
+	}
+	return n
+}
+
+// reconnectPolicyFromEnv 환경변수로 백오프 범위와 최대 재시도 횟수를 읽습니다.
+func reconnectPolicyFromEnv() (min, max time.Duration, maxRetries int) {
+	minMs := envInt(ENV_RECONNECT_MIN_MS, RECONNECT_BACKOFF_MIN_MS)
+	maxMs := envInt(ENV_RECONNECT_MAX_MS, RECONNECT_BACKOFF_MAX_MS)
+	if minMs <= 0 || maxMs < minMs {
+		log.Printf("[Admin][BOOT] 재연결 대기 범위 무시: %d~%dms", minMs, maxMs)
+		minMs, maxMs = RECONNECT_BACKOFF_MIN_MS, RECONNECT_BACKOFF_MAX_MS
+	}
+	return time.Duration(minMs) * time.Millisecond, time.Duration(maxMs) * time.Millisecond, envInt(ENV_RECONNECT_MAX_RETRIES, 0)
+}
+
+// configure 백오프 대기 하한/상한을 변경합니다. 진행 중인 시도 횟수는 유지합니다.
+func (b *reconnectBackoff) configure(min, max time.Duration) {
+	b.mu.Lock()
+	b.min = min
+	b.max = max
+	b.mu.Unlock()
+}
+
+// SetReconnectPolicy 재연결 대기 범위(ms)와 최대 연속 재시도 횟수를 변경합니다. maxRetries 0 은 무제한입니다.
+func (a *App) SetReconnectPolicy(minMs, maxMs, maxRetries int) error {
+	if minMs <= 0 || maxMs < minMs || maxRetries < 0 {
+		return fmt.Errorf("invalid reconnect policy: min=%dms max=%dms maxRetries=%d", minMs, maxMs, maxRetries)
+	}
+	a.backoff.configure(time.Duration(minMs)*time.Millisecond, time.Duration(maxMs)*time.Millisecond)
+	a.maxRetries.Store(int64(maxRetries))
+	return nil
+}
+
+// retriesExhausted 최대 재시도 횟수를 모두 소진했는지 반환합니다.
+func (a *App) retriesExhausted() bool {
+	limit := a.maxRetries.Load()
+	return limit > 0 && int64(a.backoff.state().Attempt) >= limit
+}
+
+// giveUp 재시도를 중단하고 종료 상태(terminal disconnected)를 발행합니다.
+func (a *App) giveUp(err error) {
+	a.gaveUp.Store(true)
+	log.Printf("[Admin][BOOT] 최대 재시도 %d회 초과 - 재연결 중단 (Reconnect 로 재시작)", a.maxRetries.Load())
+	a.setConnectionStatusTerminal(err)
+}
+
+// Reconnect 재시도를 포기한 상태면 백오프를 초기화하고 연결 루프를 다시 시작합니다.
+// 연결 루프가 동작 중이면 대기 없이 즉시 재연결합니다.
+func (a *App) Reconnect() {
+	if a.ctx == nil || a.ctx.Err() != nil {
+		return
+	}
+	if a.gaveUp.CompareAndSwap(true, false) {
+		log.Printf("[Admin][BOOT] 수동 재연결 시작")
+		a.backoff.reset()
+		go a.bootstrapLoop()
+		return
+	}
+	a.requestReconnect()
+}
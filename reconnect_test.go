@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+)
+
+// switchDialer는 up 이 false 인 동안 연결을 거부하고, true 가 되면 하니스로 연결합니다.
+type switchDialer struct {
+	up    atomic.Bool
+	dials atomic.Int64
+	h     *servertest.Harness
+}
+
+func (d *switchDialer) dial(ctx context.Context, addr string) (net.Conn, error) {
+	d.dials.Add(1)
+	if !d.up.Load() {
+		return nil, errors.New("connection refused")
+	}
+	return d.h.Dialer()(ctx, addr)
+}
+
+func TestReconnectGivesUpAfterMaxRetries(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	d := &switchDialer{h: h}
+	app, _ := newTestApp(WithDialer(d.dial), WithDialTimeout(100*time.Millisecond))
+	app.tls = tlsSettings{Insecure: true}
+	if err := app.SetReconnectPolicy(10, 20, 3); err != nil {
+		t.Fatalf("SetReconnectPolicy 오류 = %v", err)
+	}
+	app.startup(context.Background())
+	t.Cleanup(func() { app.shutdown(context.Background()) })
+
+	waitFor(t, "재시도 포기", nil, func() bool { return app.GetConnectionStatus().Terminal })
+	st := app.GetConnectionStatus()
+	if st.State != CONNECTION_STATE_DISCONNECTED || st.RetryCount != 3 || st.LastError == "" {
+		t.Fatalf("포기 후 연결 상태 = %+v", st)
+	}
+	// 포기 후에는 더 이상 연결을 시도하지 않음
+	dials := d.dials.Load()
+	time.Sleep(10 * 20 * time.Millisecond)
+	if got := d.dials.Load(); got != dials {
+		t.Fatalf("포기 후 연결 시도 %d → %d", dials, got)
+	}
+
+	// Reconnect 로 루프를 다시 시작하면 복구된 서버에 연결
+	d.up.Store(true)
+	app.Reconnect()
+	waitConnected(t, app)
+	waitFor(t, "재연결 후 connected 상태", nil, func() bool {
+		st := app.GetConnectionStatus()
+		return st.State == CONNECTION_STATE_CONNECTED && !st.Terminal
+	})
+}
+
+func TestSetReconnectPolicyRejectsInvalid(t *testing.T) {
+	app, _ := newTestApp()
+	for _, p := range [][3]int{{0, 100, 0}, {200, 100, 0}, {100, 200, -1}} {
+		if err := app.SetReconnectPolicy(p[0], p[1], p[2]); err == nil {
+			t.Fatalf("SetReconnectPolicy%v 가 허용됨", p)
+		}
+	}
+}
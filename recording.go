@@ -0,0 +1,129 @@
+package main
+
+// Detail 스트림 녹화
+// - StartRecording 은 Detail 스트림 프레임을 프론트로 계속 전달하면서 디렉터리에 <timestamp>-<sequence> 이름의 파일로 저장
+//   (같은 밀리초 프레임이 덮어쓰지 않도록 서버 순번 포함, 확장자는 프레임 이미지 형식에 따름)
+// - 저장 실패(디스크 부족 등) 시 녹화를 중지하고 streamStatus 이벤트로 알림 (녹화용으로 연 Detail 스트림도 중지)
+// - 녹화가 의존하는 Detail 스트림을 StopDetail 로 중지하면 녹화도 함께 종료하고 streamStatus 이벤트로 알림
+// - 녹화 디렉터리도 SaveFrame 과 동일하게 스냅샷 디렉터리 하위로 제한
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"admin/proto"
+)
+
+const (
+	// 녹화 스트림 종류 (streamStatus 이벤트용)
+	STREAM_KIND_RECORDING = "recording"
+)
+
+// recording은 Agent 별 녹화 상태입니다.
+type recording struct {
+	dir string
+	// StartRecording 이 Detail 스트림을 직접 시작했는지 여부 (중지 시 함께 정리)
+	ownsDetail bool
+}
+
+// StartRecording 특정 Agent 의 Detail 프레임을 dir 에 파일로 저장하기 시작합니다.
+// Detail 스트림이 없으면 함께 시작합니다.
+func (a *App) StartRecording(agentId, dir string) error {
+	if agentId == "" {
+		return errors.New("agentId is required")
+	}
+	base, err := snapshotBaseDir()
+	if err != nil {
+		return err
+	}
+	target, err := resolveSavePath(base, dir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(target, 0o755); err != nil {
+		return fmt.Errorf("create recording dir: %w", err)
+	}
+
+	a.recordMu.Lock()
+	if _, ok := a.recordings[agentId]; ok {
+		a.recordMu.Unlock()
+		return fmt.Errorf("agent %q is already recording", agentId)
+	}
+	a.detailMu.Lock()
+	_, detailRunning := a.detailStreams[agentId]
+	a.detailMu.Unlock()
+	a.recordings[agentId] = &recording{dir: target, ownsDetail: !detailRunning}
+	a.recordMu.Unlock()
+
+	if !detailRunning {
+		if err := a.StartDetail(agentId); err != nil {
+			a.recordMu.Lock()
+			delete(a.recordings, agentId)
+			a.recordMu.Unlock()
+			return err
+		}
+	}
+	log.Printf("[Admin][REC] %s 녹화 시작: %s", agentId, target)
+	return nil
+}
+
+// StopRecording 특정 Agent 의 녹화를 중지합니다. 녹화용으로 시작한 Detail 스트림도 함께 중지합니다.
+func (a *App) StopRecording(agentId string) {
+	a.recordMu.Lock()
+	rec, ok := a.recordings[agentId]
+	delete(a.recordings, agentId)
+	a.recordMu.Unlock()
+	if !ok {
+		return
+	}
+	if rec.ownsDetail {
+		a.StopDetail(agentId)
+	}
+	log.Printf("[Admin][REC] %s 녹화 중지", agentId)
+}
+
+// endRecordingForDetail StopDetail 로 Detail 스트림이 중지될 때 해당 Agent 의 녹화도 종료하고 알립니다.
+// (프레임을 받을 스트림이 없으므로 남겨 두면 아무것도 저장하지 않는 녹화가 됨)
+func (a *App) endRecordingForDetail(agentId string) {
+	a.recordMu.Lock()
+	_, ok := a.recordings[agentId]
+	delete(a.recordings, agentId)
+	a.recordMu.Unlock()
+	if !ok {
+		return
+	}
+	log.Printf("[Admin][REC] %s Detail 중지로 녹화 종료", agentId)
+	a.emitStreamStatus(STREAM_KIND_RECORDING, agentId, STREAM_STATE_CLOSED, errors.New("detail stream stopped"))
+}
+
+// recordFrame 녹화 중인 Agent 의 프레임을 파일로 저장합니다. 실패 시 녹화를 중지합니다.
+func (a *App) recordFrame(agentId string, frame *proto.FrameData) {
+	if len(frame.GetImageData()) == 0 {
+		return
+	}
+	a.recordMu.Lock()
+	rec, ok := a.recordings[agentId]
+	a.recordMu.Unlock()
+	if !ok {
+		return
+	}
+	name := filepath.Join(rec.dir, fmt.Sprintf("%d-%d%s", frame.GetTimestamp(), frame.GetSequence(), frameFileExt(frame)))
+	if err := os.WriteFile(name, frame.GetImageData(), 0o644); err != nil {
+		log.Printf("[Admin][REC] %s 저장 실패 - 녹화 중지: %v", agentId, err)
+		a.recordMu.Lock()
+		stopped := a.recordings[agentId] == rec
+		if stopped {
+			delete(a.recordings, agentId)
+		}
+		a.recordMu.Unlock()
+		// 녹화용으로 시작한 Detail 스트림도 lock 해제 후 정리 (StopRecording 과 동일)
+		// 이 함수는 해당 스트림의 수신 고루틴에서 호출되므로 종료를 기다리는 StopDetail 은 별도 고루틴에서 실행
+		if stopped && rec.ownsDetail {
+			go a.StopDetail(agentId)
+		}
+		a.emitStreamStatus(STREAM_KIND_RECORDING, agentId, STREAM_STATE_CLOSED, err)
+	}
+}
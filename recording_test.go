@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"admin/internal/server/servertest"
+	"admin/proto"
+)
+
+// recordedContents 디렉터리의 녹화 파일 내용을 정렬해 반환합니다.
+func recordedContents(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, string(data))
+	}
+	slices.Sort(out)
+	return out
+}
+
+func TestRecordingWritesFrames(t *testing.T) {
+	base := t.TempDir()
+	t.Setenv(ENV_SNAPSHOT_DIR, base)
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+
+	if err := app.StartRecording("agent-1", "clip"); err != nil {
+		t.Fatalf("StartRecording 오류 = %v", err)
+	}
+	waitFor(t, "서버 Detail 구독 등록", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	// 같은 밀리초에 도착해도 순번으로 파일이 구분되어야 함
+	for i := range 3 {
+		pushFrame(h, "agent-1", fmt.Sprintf("frame-%d", i))
+	}
+	dir := filepath.Join(base, "clip")
+	want := []string{"frame-0", "frame-1", "frame-2"}
+	waitFor(t, "녹화 파일 3개", nil, func() bool { return len(recordedContents(t, dir)) == len(want) })
+	if got := recordedContents(t, dir); !slices.Equal(got, want) {
+		t.Fatalf("녹화 파일 내용 = %v, want %v", got, want)
+	}
+
+	app.StopRecording("agent-1")
+	waitFor(t, "녹화용 Detail 구독 해제", nil, func() bool { return h.Service.Stats().DetailSubscribers == 0 })
+}
+
+func TestRecordingStopsOnWriteFailure(t *testing.T) {
+	base := t.TempDir()
+	t.Setenv(ENV_SNAPSHOT_DIR, base)
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	waitConnected(t, app)
+
+	if err := app.StartRecording("agent-1", "clip"); err != nil {
+		t.Fatalf("StartRecording 오류 = %v", err)
+	}
+	waitFor(t, "서버 Detail 구독 등록", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	// 녹화 디렉터리를 지워 저장이 실패하게 함
+	if err := os.RemoveAll(filepath.Join(base, "clip")); err != nil {
+		t.Fatal(err)
+	}
+	pushFrame(h, "agent-1", "frame")
+
+	waitFor(t, "녹화 중지 알림", nil, func() bool {
+		for _, data := range rec.named(EVENT_STREAM_STATUS) {
+			payload, _ := data.(map[string]any)
+			if payload["kind"] == STREAM_KIND_RECORDING && payload["state"] == STREAM_STATE_CLOSED && payload["error"] != nil {
+				return true
+			}
+		}
+		return false
+	})
+	app.recordMu.Lock()
+	_, recording := app.recordings["agent-1"]
+	app.recordMu.Unlock()
+	if recording {
+		t.Fatal("저장 실패 후에도 녹화 중")
+	}
+	waitFor(t, "녹화용 Detail 구독 해제", nil, func() bool { return h.Service.Stats().DetailSubscribers == 0 })
+}
+
+func TestStopDetailEndsDependentRecording(t *testing.T) {
+	base := t.TempDir()
+	t.Setenv(ENV_SNAPSHOT_DIR, base)
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	waitConnected(t, app)
+
+	// 이미 열린 Detail 스트림에 녹화를 붙인 뒤(ownsDetail=false) Detail 을 중지
+	if err := app.StartDetail("agent-1"); err != nil {
+		t.Fatalf("StartDetail 오류 = %v", err)
+	}
+	if err := app.StartRecording("agent-1", "clip"); err != nil {
+		t.Fatalf("StartRecording 오류 = %v", err)
+	}
+	app.StopDetail("agent-1")
+
+	app.recordMu.Lock()
+	_, recording := app.recordings["agent-1"]
+	app.recordMu.Unlock()
+	if recording {
+		t.Fatal("StopDetail 후에도 녹화 중")
+	}
+	var closed int
+	for _, data := range rec.named(EVENT_STREAM_STATUS) {
+		payload, _ := data.(map[string]any)
+		if payload["kind"] == STREAM_KIND_RECORDING && payload["state"] == STREAM_STATE_CLOSED {
+			closed++
+		}
+	}
+	if closed != 1 {
+		t.Fatalf("녹화 종료 알림 = %d, want 1", closed)
+	}
+}
+
+func TestFrameFileExt(t *testing.T) {
+	tests := []struct {
+		format proto.ImageFormat
+		want   string
+	}{
+		{proto.ImageFormat_IMAGE_FORMAT_UNSPECIFIED, ".jpg"},
+		{proto.ImageFormat_IMAGE_FORMAT_JPEG, ".jpg"},
+		{proto.ImageFormat_IMAGE_FORMAT_PNG, ".png"},
+		{proto.ImageFormat_IMAGE_FORMAT_WEBP, ".webp"},
+		{proto.ImageFormat_IMAGE_FORMAT_UNKNOWN, ".bin"},
+	}
+	for _, tt := range tests {
+		if got := frameFileExt(&proto.FrameData{Format: tt.format}); got != tt.want {
+			t.Errorf("frameFileExt(%v) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestRecordingExtensionFollowsFormat(t *testing.T) {
+	base := t.TempDir()
+	t.Setenv(ENV_SNAPSHOT_DIR, base)
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, _ := startTestApp(t, h)
+	waitConnected(t, app)
+
+	if err := app.StartRecording("agent-1", "clip"); err != nil {
+		t.Fatalf("StartRecording 오류 = %v", err)
+	}
+	waitFor(t, "서버 Detail 구독 등록", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+	// 서버가 매직 바이트로 PNG 를 판별해 Format 을 채움
+	pushFrame(h, "agent-1", "\x89PNG\r\n\x1a\nimage")
+	dir := filepath.Join(base, "clip")
+	waitFor(t, "녹화 파일", nil, func() bool { return len(recordedContents(t, dir)) == 1 })
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := entries[0].Name(); filepath.Ext(name) != ".png" {
+		t.Fatalf("녹화 파일 이름 = %q, want .png 확장자", name)
+	}
+}
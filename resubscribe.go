@@ -0,0 +1,60 @@
+package main
+
+// 재연결 후 Detail/Events 구독 복구
+// - bootstrapLoop 재연결 시 Overview 만 다시 열리므로, 사용자가 열어 둔(Stop 하지 않은) 구독을 새 연결로 다시 엶
+// - 이전 연결에 묶인 스트림은 먼저 취소/종료 대기 후 교체 (수신 고루틴이 맵을 정리하므로 잠금 밖에서 대기)
+// - 복구 결과는 스트림별 streamStatus 이벤트로 알림
+
+import (
+	"log"
+	"sync"
+)
+
+// resubscribeStreams 구독 의사가 남아 있는 Detail/Events 스트림을 현재 연결로 다시 엽니다.
+func (a *App) resubscribeStreams() {
+	client := a.client()
+	if client == nil {
+		return
+	}
+	a.reopenStreams(STREAM_KIND_DETAIL, &a.detailMu, a.detailStreams, a.detailWanted, func(agentId string) (bool, error) {
+		return a.openDetail(client, agentId, true)
+	})
+	a.reopenStreams(STREAM_KIND_EVENTS, &a.eventsMu, a.eventStreams, a.eventsWanted, func(agentId string) (bool, error) {
+		return a.openEvents(client, agentId, true)
+	})
+}
+
+// reopenStreams 한 종류의 스트림 맵에 대해 기존 스트림을 닫고 구독 의사가 있는 Agent 를 다시 구독합니다.
+// open 은 mu 잠금 밖에서 호출되며, 그사이 다시 열렸거나 Stop 된 Agent 는 건너뜁니다.
+func (a *App) reopenStreams(kind string, mu *sync.Mutex, streams map[string]*streamHandle, wanted map[string]struct{}, open func(agentId string) (bool, error)) {
+	mu.Lock()
+	stale := make([]*streamHandle, 0, len(streams))
+	for _, h := range streams {
+		stale = append(stale, h)
+	}
+	mu.Unlock()
+	for _, h := range stale {
+		h.cancel()
+		<-h.done
+	}
+
+	mu.Lock()
+	agentIds := make([]string, 0, len(wanted))
+	for agentId := range wanted {
+		if _, ok := streams[agentId]; !ok {
+			agentIds = append(agentIds, agentId)
+		}
+	}
+	mu.Unlock()
+	for _, agentId := range agentIds {
+		opened, err := open(agentId)
+		if err != nil {
+			log.Printf("[Admin][STREAM] %s(%s) 재구독 실패: %v", kind, agentId, err)
+			a.emitStreamStatus(kind, agentId, STREAM_STATE_CLOSED, err)
+			continue
+		}
+		if opened {
+			a.emitStreamStatus(kind, agentId, STREAM_STATE_RESUBSCRIBED, nil)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"admin/internal/server/servertest"
+)
+
+// streamStatuses kind/agentId 스트림의 streamStatus 이벤트 state 목록을 반환합니다.
+func streamStatuses(rec *eventRecorder, kind, agentId string) []string {
+	var states []string
+	for _, data := range rec.named(EVENT_STREAM_STATUS) {
+		payload, _ := data.(map[string]any)
+		if payload["kind"] == kind && payload["agentId"] == agentId {
+			state, _ := payload["state"].(string)
+			states = append(states, state)
+		}
+	}
+	return states
+}
+
+func TestReconnectRestoresDetailSubscriptions(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	waitConnected(t, app)
+
+	for _, agentId := range []string{"agent-1", "agent-2"} {
+		if err := app.StartDetail(agentId); err != nil {
+			t.Fatalf("StartDetail(%s) 오류 = %v", agentId, err)
+		}
+	}
+	waitFor(t, "서버 Detail 구독 2개 등록", nil, func() bool { return h.Service.Stats().DetailSubscribers == 2 })
+	// 사용자가 닫은 구독은 재연결 후에도 되살아나지 않아야 함
+	app.StopDetail("agent-2")
+	waitFor(t, "agent-2 구독 해제", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+
+	// 연결을 끊어 bootstrapLoop 재연결 유도
+	app.requestReconnect()
+	waitFor(t, "agent-1 재구독 상태 이벤트", nil, func() bool {
+		for _, state := range streamStatuses(rec, STREAM_KIND_DETAIL, "agent-1") {
+			if state == STREAM_STATE_RESUBSCRIBED {
+				return true
+			}
+		}
+		return false
+	})
+	waitFor(t, "재연결 후 서버 Detail 구독 1개", nil, func() bool { return h.Service.Stats().DetailSubscribers == 1 })
+
+	before := len(rec.named(EVENT_DETAIL_FRAME_PREFIX + "agent-1"))
+	waitFor(t, "재구독한 스트림으로 detailFrame 수신", func() { pushFrame(h, "agent-1", "after-reconnect") }, func() bool {
+		return len(rec.named(EVENT_DETAIL_FRAME_PREFIX+"agent-1")) > before
+	})
+	for _, state := range streamStatuses(rec, STREAM_KIND_DETAIL, "agent-2") {
+		if state == STREAM_STATE_RESUBSCRIBED {
+			t.Fatal("StopDetail 한 agent-2 구독이 재연결 후 복구됨")
+		}
+	}
+	app.detailMu.Lock()
+	_, resurrected := app.detailStreams["agent-2"]
+	app.detailMu.Unlock()
+	if resurrected {
+		t.Fatal("StopDetail 한 agent-2 스트림이 남아 있음")
+	}
+}
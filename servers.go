@@ -0,0 +1,188 @@
+package main
+
+// 다중 서버 연결
+// - 기본 서버(SetServerAddress) 외에 AddServer 로 다른 지역 서버의 Overview 를 함께 수신
+// - 서버마다 serverConnection 이 자체 재연결 루프/백오프, 프레임 캐시, cancel 을 가짐
+// - 프레임 이벤트에는 server 필드로 출처 서버 주소를 실어 보내고, GetLatestFrames 는 전체 서버를 합쳐 반환
+// - TLS/토큰/Admin ID/연결 제한 시간(a.dial)은 기본 서버와 동일한 설정을 사용
+// - 수신 프레임은 기본 서버와 같은 인코딩 워커(raw 모드 포함)를 거쳐 캐시/발행
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"admin/proto"
+)
+
+const (
+	// 추가 서버 연결 상태 이벤트 이름
+	EVENT_SERVER_STATUS = "serverStatus"
+	// 추가 서버 Overview 발행 속도 제한 키 구분자 (주소 + 구분자 + agentId)
+	SERVER_EMIT_KEY_SEPARATOR = "|"
+)
+
+// serverConnection는 AddServer 로 추가한 서버 하나의 연결 상태와 프레임 캐시입니다.
+type serverConnection struct {
+	addr    string
+	cancel  context.CancelFunc
+	done    chan struct{}
+	backoff *reconnectBackoff
+	// Agent 별 최신 프레임 (이 서버에서 받은 것만)
+	framesMu     sync.RWMutex
+	latestFrames map[string]*frameSnapshot
+}
+
+// store 이 서버의 최신 프레임을 캐시합니다.
+func (sc *serverConnection) store(snap *frameSnapshot) {
+	sc.framesMu.Lock()
+	sc.latestFrames[snap.AgentID] = snap
+	sc.framesMu.Unlock()
+}
+
+// snapshots 이 서버의 최신 프레임 복사본을 반환합니다.
+func (sc *serverConnection) snapshots() []frameSnapshot {
+	sc.framesMu.RLock()
+	defer sc.framesMu.RUnlock()
+	list := make([]frameSnapshot, 0, len(sc.latestFrames))
+	for _, v := range sc.latestFrames {
+		list = append(list, *v)
+	}
+	return list
+}
+
+// AddServer 추가 서버의 Overview 수신을 시작합니다. 이미 추가된 주소나 기본 서버 주소면 에러입니다.
+func (a *App) AddServer(addr string) error {
+	if err := validateServerAddress(addr); err != nil {
+		return err
+	}
+	if addr == a.serverAddress() {
+		return errors.New("address is the primary server")
+	}
+	a.serversMu.Lock()
+	defer a.serversMu.Unlock()
+	if _, ok := a.servers[addr]; ok {
+		return fmt.Errorf("server %s already added", addr)
+	}
+	ctx, cancel := context.WithCancel(a.ctx)
+	sc := &serverConnection{
+		addr:         addr,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		backoff:      newReconnectBackoff(RECONNECT_BACKOFF_MIN_MS*time.Millisecond, RECONNECT_BACKOFF_MAX_MS*time.Millisecond),
+		latestFrames: make(map[string]*frameSnapshot),
+	}
+	a.servers[addr] = sc
+	go a.serverLoop(ctx, sc)
+	log.Printf("[Admin][BOOT] 서버 추가: %s", addr)
+	return nil
+}
+
+// RemoveServer 추가 서버 연결을 끊고 캐시를 제거합니다. 추가되지 않은 주소면 에러입니다.
+func (a *App) RemoveServer(addr string) error {
+	a.serversMu.Lock()
+	sc, ok := a.servers[addr]
+	delete(a.servers, addr)
+	a.serversMu.Unlock()
+	if !ok {
+		return fmt.Errorf("server %s not found", addr)
+	}
+	sc.cancel()
+	<-sc.done
+	log.Printf("[Admin][BOOT] 서버 제거: %s", addr)
+	return nil
+}
+
+// GetServers 추가된 서버 주소 목록을 반환합니다. (기본 서버 제외)
+func (a *App) GetServers() []string {
+	addrs := make([]string, 0)
+	for _, sc := range a.serverConnections() {
+		addrs = append(addrs, sc.addr)
+	}
+	return addrs
+}
+
+// serverConnections 추가 서버 연결 목록을 복사하여 반환합니다.
+func (a *App) serverConnections() []*serverConnection {
+	a.serversMu.Lock()
+	defer a.serversMu.Unlock()
+	list := make([]*serverConnection, 0, len(a.servers))
+	for _, sc := range a.servers {
+		list = append(list, sc)
+	}
+	return list
+}
+
+// emitServerStatus 추가 서버의 연결 상태 변화를 프론트로 알립니다.
+func (a *App) emitServerStatus(addr, state string, err error) {
+	payload := map[string]any{
+		"server": addr,
+		"state":  state,
+	}
+	if err != nil {
+		payload["error"] = err.Error()
+	}
+	a.emit(EVENT_SERVER_STATUS, payload)
+}
+
+// serverLoop 추가 서버 연결 및 재시도 루프입니다. ctx 가 취소되면 종료합니다.
+func (a *App) serverLoop(ctx context.Context, sc *serverConnection) {
+	defer close(sc.done)
+	for {
+		started := time.Now()
+		a.emitServerStatus(sc.addr, CONNECTION_STATE_CONNECTING, nil)
+		err := a.runServerConnection(ctx, sc)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) >= RECONNECT_STABLE_MS*time.Millisecond {
+			sc.backoff.reset()
+		}
+		delay := sc.backoff.next()
+		a.emitServerStatus(sc.addr, CONNECTION_STATE_DISCONNECTED, err)
+		log.Printf("[Admin][BOOT] 서버 %s 연결 종료: %v (재시도 %s 후)", sc.addr, err, delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// runServerConnection 추가 서버에 연결하여 Overview 스트림이 끝날 때까지 수신합니다.
+func (a *App) runServerConnection(ctx context.Context, sc *serverConnection) error {
+	opts, err := a.dialOptions()
+	if err != nil {
+		return err
+	}
+	conn, err := a.dial(sc.addr, opts)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+	client := proto.NewAdminServiceClient(conn)
+	if err := checkHealth(ctx, client); err != nil {
+		return err
+	}
+	stream, err := client.SubscribeOverview(ctx, &proto.AdminSubscribeRequest{AdminId: a.adminID, SubscriptionId: OVERVIEW_SUBSCRIPTION_ID})
+	if err != nil {
+		return fmt.Errorf("subscribe overview: %w", err)
+	}
+	a.emitServerStatus(sc.addr, CONNECTION_STATE_CONNECTED, nil)
+	for {
+		frame, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("recv: %w", err)
+		}
+		if isHeartbeatFrame(frame) {
+			continue
+		}
+		// 인코딩/캐시/발행은 워커에서 처리해 Recv 를 막지 않음
+		a.encoder.put(encodeJob{frame: frame, server: sc.addr, sc: sc})
+	}
+}
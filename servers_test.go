@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"admin/internal/server/servertest"
+)
+
+// 다중 서버 테스트에서 사용하는 서버 주소
+const (
+	TEST_PRIMARY_ADDRESS   = "primary:50051"
+	TEST_SECONDARY_ADDRESS = "secondary:50051"
+)
+
+// routeDialer 주소별로 다른 하니스에 연결하는 dialer 를 반환합니다.
+func routeDialer(routes map[string]*servertest.Harness) func(context.Context, string) (net.Conn, error) {
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		h, ok := routes[addr]
+		if !ok {
+			return nil, &net.AddrError{Err: "unknown test server", Addr: addr}
+		}
+		return h.Dialer()(ctx, addr)
+	}
+}
+
+// frameServers GetLatestFrames 결과를 "agentId@server" 집합으로 반환합니다.
+func frameServers(app *App) map[string]bool {
+	out := make(map[string]bool)
+	for _, snap := range app.GetLatestFrames() {
+		out[snap.AgentID+"@"+snap.Server] = true
+	}
+	return out
+}
+
+func TestMultiServerAddRemoveAndAggregate(t *testing.T) {
+	t.Setenv(ENV_GRPC_SERVER_ADDRESS, TEST_PRIMARY_ADDRESS)
+	primary := servertest.Start(nil)
+	defer primary.Close()
+	secondary := servertest.Start(nil)
+	defer secondary.Close()
+	app, rec := startTestApp(t, primary, WithDialer(routeDialer(map[string]*servertest.Harness{
+		TEST_PRIMARY_ADDRESS:   primary,
+		TEST_SECONDARY_ADDRESS: secondary,
+	})))
+	waitConnected(t, app)
+
+	if err := app.AddServer(TEST_SECONDARY_ADDRESS); err != nil {
+		t.Fatalf("AddServer 오류 = %v", err)
+	}
+	if err := app.AddServer(TEST_SECONDARY_ADDRESS); err == nil {
+		t.Fatal("중복 AddServer 가 허용됨")
+	}
+	if err := app.AddServer(TEST_PRIMARY_ADDRESS); err == nil {
+		t.Fatal("기본 서버 주소 AddServer 가 허용됨")
+	}
+	if got := app.GetServers(); len(got) != 1 || got[0] != TEST_SECONDARY_ADDRESS {
+		t.Fatalf("GetServers = %v", got)
+	}
+
+	// 두 서버의 프레임이 출처 서버와 함께 합쳐져야 함
+	waitFor(t, "두 서버 프레임 집계", func() {
+		pushFrame(primary, "agent-1", "primary")
+		pushFrame(secondary, "agent-2", "secondary")
+	}, func() bool {
+		got := frameServers(app)
+		return got["agent-1@"+TEST_PRIMARY_ADDRESS] && got["agent-2@"+TEST_SECONDARY_ADDRESS]
+	})
+	// 발행 속도 제한으로 이벤트는 캐시보다 늦게 나갈 수 있음
+	waitFor(t, "추가 서버 프레임 이벤트에 출처 서버 포함", nil, func() bool {
+		for _, data := range rec.named(EVENT_OVERVIEW_FRAME) {
+			payload, _ := data.(map[string]any)
+			if payload["agentId"] == "agent-2" && payload["server"] == TEST_SECONDARY_ADDRESS {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err := app.RemoveServer(TEST_SECONDARY_ADDRESS); err != nil {
+		t.Fatalf("RemoveServer 오류 = %v", err)
+	}
+	if err := app.RemoveServer(TEST_SECONDARY_ADDRESS); err == nil {
+		t.Fatal("제거된 서버 RemoveServer 가 허용됨")
+	}
+	if got := app.GetServers(); len(got) != 0 {
+		t.Fatalf("RemoveServer 후 GetServers = %v", got)
+	}
+	got := frameServers(app)
+	if got["agent-2@"+TEST_SECONDARY_ADDRESS] || !got["agent-1@"+TEST_PRIMARY_ADDRESS] {
+		t.Fatalf("RemoveServer 후 GetLatestFrames = %v", got)
+	}
+	waitFor(t, "추가 서버 구독 해제", nil, func() bool { return secondary.Service.Stats().OverviewSubscribers == 0 })
+}
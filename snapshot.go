@@ -0,0 +1,79 @@
+package main
+
+// 프레임 파일 저장
+// - SaveFrame 으로 캐시된 최신 프레임을 이미지 파일로 저장 (사건 보고용)
+// - 프론트가 임의 경로를 넘길 수 있으므로 저장 위치는 스냅샷 디렉터리 하위로 제한
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// 스냅샷 저장 디렉터리 환경변수 이름 (미설정 시 홈 디렉터리 하위 SNAPSHOT_DIR_NAME)
+	ENV_SNAPSHOT_DIR  = "ADMIN_SNAPSHOT_DIR"
+	SNAPSHOT_DIR_NAME = "admin-snapshots"
+)
+
+// snapshotBaseDir 스냅샷 저장 기준 디렉터리를 반환합니다.
+func snapshotBaseDir() (string, error) {
+	if dir := os.Getenv(ENV_SNAPSHOT_DIR); dir != "" {
+		return filepath.Abs(dir)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve home dir: %w", err)
+	}
+	return filepath.Join(home, SNAPSHOT_DIR_NAME), nil
+}
+
+// resolveSavePath 저장 경로를 기준 디렉터리 하위의 절대 경로로 변환합니다.
+// 상대 경로는 기준 디렉터리 기준으로 해석하며, 기준 디렉터리를 벗어나는 경로는 거부합니다.
+func resolveSavePath(base, path string) (string, error) {
+	if path == "" {
+		return "", errors.New("path is required")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(base, path)
+	}
+	path = filepath.Clean(path)
+	rel, err := filepath.Rel(base, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %q is outside snapshot directory %s", path, base)
+	}
+	return path, nil
+}
+
+// SaveFrame 특정 Agent 의 최신 프레임을 파일로 저장합니다.
+// 캐시된 프레임이 없거나 오프라인(빈 이미지)이면 에러를 반환합니다.
+func (a *App) SaveFrame(agentId, path string) error {
+	snap, ok := a.GetLatestFrame(agentId)
+	if !ok {
+		return fmt.Errorf("no frame cached for agent %q", agentId)
+	}
+	if snap.ImageBase == "" && len(snap.ImageBytes) == 0 {
+		return fmt.Errorf("agent %q has no image data (offline)", agentId)
+	}
+	data, err := frameImageBytes(snap)
+	if err != nil {
+		return fmt.Errorf("decode frame: %w", err)
+	}
+	base, err := snapshotBaseDir()
+	if err != nil {
+		return err
+	}
+	target, err := resolveSavePath(base, path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("create snapshot dir: %w", err)
+	}
+	if err := os.WriteFile(target, data, 0o644); err != nil {
+		return fmt.Errorf("write frame: %w", err)
+	}
+	return nil
+}
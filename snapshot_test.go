@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"admin/proto"
+)
+
+func TestSaveFrameWritesOriginalBytes(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(ENV_SNAPSHOT_DIR, dir)
+	app, _ := newTestApp()
+	image := []byte{0xff, 0xd8, 0xff, 0x00, 0x01, 0x02}
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: image})
+
+	if err := app.SaveFrame("agent-1", "incident/agent-1.jpg"); err != nil {
+		t.Fatalf("SaveFrame 오류 = %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "incident", "agent-1.jpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, image) {
+		t.Fatalf("저장된 파일 = %x, want %x", got, image)
+	}
+}
+
+func TestSaveFrameErrors(t *testing.T) {
+	t.Setenv(ENV_SNAPSHOT_DIR, t.TempDir())
+	app, _ := newTestApp()
+	storeTestFrame(app, &proto.FrameData{AgentId: "offline", Offline: true})
+	storeTestFrame(app, &proto.FrameData{AgentId: "agent-1", ImageData: []byte("img")})
+
+	for name, tt := range map[string]struct{ agentId, path string }{
+		"캐시 없음":   {"unknown", "a.jpg"},
+		"오프라인":    {"offline", "a.jpg"},
+		"디렉터리 이탈": {"agent-1", "../escape.jpg"},
+		"빈 경로":    {"agent-1", ""},
+	} {
+		if err := app.SaveFrame(tt.agentId, tt.path); err == nil {
+			t.Fatalf("%s: SaveFrame 성공, want 오류", name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+// 멈춘 피드 감지
+// - 서버가 오프라인을 알리기 전이라도 프레임이 끊긴 타일을 프론트가 회색 처리할 수 있도록 StalledAgents 제공
+// - 기준은 스냅샷의 Agent 타임스탬프(ms), 상태 신호(online 등 0 이하 sentinel)는 수신 시각으로 대체
+// - 오프라인 스냅샷은 이미 오프라인으로 표시되므로 제외
+
+import (
+	"sort"
+	"time"
+)
+
+// StalledAgents 최신 스냅샷이 threshold 보다 오래된 Agent 목록을 agentId 순으로 반환합니다. (오프라인 제외)
+func (a *App) StalledAgents(threshold time.Duration) []string {
+	cutoff := time.Now().Add(-threshold).UnixMilli()
+	stalled := make([]string, 0)
+	a.framesMu.RLock()
+	for agentId, snap := range a.latestFrames {
+		if snap.Offline {
+			continue
+		}
+		ts := snap.Timestamp
+		if ts <= OFFLINE_TIMESTAMP {
+			ts = snap.ReceivedAt
+		}
+		if ts < cutoff {
+			stalled = append(stalled, agentId)
+		}
+	}
+	a.framesMu.RUnlock()
+	sort.Strings(stalled)
+	return stalled
+}
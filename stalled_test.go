@@ -0,0 +1,27 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"admin/proto"
+)
+
+func TestStalledAgents(t *testing.T) {
+	app, _ := newTestApp()
+	now := time.Now()
+	storeTestFrame(app, &proto.FrameData{AgentId: "fresh", ImageData: []byte("a"), Timestamp: now.UnixMilli()})
+	storeTestFrame(app, &proto.FrameData{AgentId: "stale-1", ImageData: []byte("b"), Timestamp: now.Add(-time.Minute).UnixMilli()})
+	storeTestFrame(app, &proto.FrameData{AgentId: "stale-2", ImageData: []byte("c"), Timestamp: now.Add(-2 * time.Minute).UnixMilli()})
+	// 오프라인 스냅샷은 타임스탬프가 sentinel(0) 이어도 멈춘 피드로 세지 않음
+	storeTestFrame(app, &proto.FrameData{AgentId: "offline", Offline: true})
+
+	got := app.StalledAgents(30 * time.Second)
+	if want := []string{"stale-1", "stale-2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("StalledAgents(30s) = %v, want %v", got, want)
+	}
+	if got := app.StalledAgents(time.Hour); len(got) != 0 {
+		t.Fatalf("StalledAgents(1h) = %v, want 없음", got)
+	}
+}
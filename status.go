@@ -0,0 +1,90 @@
+package main
+
+// 연결 상태 이벤트
+// - bootstrapLoop/connectAndSubscribe 에서 상태가 바뀔 때마다 프론트로 connectionStatus 이벤트 발행
+// - 타이머가 아닌 상태 전이 시점에만 발행
+// - Detail/Events 개별 스트림 상태는 streamStatus 이벤트로 발행
+
+const (
+	// 연결 상태 이벤트 이름
+	EVENT_CONNECTION_STATUS = "connectionStatus"
+	// 연결 상태 값
+	CONNECTION_STATE_CONNECTING   = "connecting"
+	CONNECTION_STATE_CONNECTED    = "connected"
+	CONNECTION_STATE_DISCONNECTED = "disconnected"
+	// 개별 구독 스트림 상태 이벤트 이름
+	EVENT_STREAM_STATUS = "streamStatus"
+	// 구독 스트림 종류
+	STREAM_KIND_DETAIL = "detail"
+	STREAM_KIND_EVENTS = "events"
+	// 구독 스트림 상태 값
+	STREAM_STATE_CLOSED       = "closed"
+	STREAM_STATE_RESUBSCRIBED = "resubscribed"
+)
+
+// connectionStatus는 프론트로 전달하는 연결 상태입니다.
+type connectionStatus struct {
+	State         string `json:"state"`
+	ServerAddress string `json:"serverAddress"`
+	RetryCount    int    `json:"retryCount"`
+	LastError     string `json:"lastError"`
+	// 최대 재시도 초과로 자동 재연결을 멈춘 상태 (Reconnect 호출 필요)
+	Terminal bool `json:"terminal"`
+}
+
+// setConnectionStatus 연결 상태를 갱신하고, 이전 상태와 다르면 이벤트를 발행합니다.
+func (a *App) setConnectionStatus(state string, err error) {
+	a.publishConnectionStatus(a.newConnectionStatus(state, err))
+}
+
+// setConnectionStatusTerminal 재시도를 포기한 disconnected 상태를 발행합니다.
+func (a *App) setConnectionStatusTerminal(err error) {
+	st := a.newConnectionStatus(CONNECTION_STATE_DISCONNECTED, err)
+	st.Terminal = true
+	a.publishConnectionStatus(st)
+}
+
+// newConnectionStatus 현재 주소/재시도 횟수로 연결 상태 값을 만듭니다.
+func (a *App) newConnectionStatus(state string, err error) connectionStatus {
+	st := connectionStatus{
+		State:         state,
+		ServerAddress: a.serverAddress(),
+		RetryCount:    a.backoff.state().Attempt,
+	}
+	if err != nil {
+		st.LastError = err.Error()
+	}
+	return st
+}
+
+// publishConnectionStatus 연결 상태를 저장하고, 이전 상태와 다르면 이벤트를 발행합니다.
+func (a *App) publishConnectionStatus(st connectionStatus) {
+	a.mu.Lock()
+	changed := a.status != st
+	a.status = st
+	a.mu.Unlock()
+	if !changed || a.ctx == nil {
+		return
+	}
+	a.emit(EVENT_CONNECTION_STATUS, st)
+}
+
+// GetConnectionStatus 마지막으로 발행한 연결 상태를 반환합니다.
+func (a *App) GetConnectionStatus() connectionStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.status
+}
+
+// emitStreamStatus Detail/Events 등 개별 구독 스트림의 상태 변화를 프론트로 알립니다.
+func (a *App) emitStreamStatus(kind, agentId, state string, err error) {
+	payload := map[string]any{
+		"kind":    kind,
+		"agentId": agentId,
+		"state":   state,
+	}
+	if err != nil {
+		payload["error"] = err.Error()
+	}
+	a.emit(EVENT_STREAM_STATUS, payload)
+}
@@ -0,0 +1,130 @@
+package main
+
+// Overview 이벤트 발행 속도 제한
+// - 수신 프레임마다 이벤트를 발행하면 JS 브리지가 밀려 UI 가 멈추므로, Agent 별 최신 프레임만 보관했다가 주기적으로 발행
+// - 같은 주기 안에 들어온 중간 프레임은 버림 (latestFrames 캐시는 수신 즉시 갱신되므로 GetLatestFrames 는 항상 최신)
+// - SetOverviewEmitRate 로 Agent 당 초당 최대 발행 횟수 변경, 0 이하이면 제한 없이 즉시 발행
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Agent 당 초당 최대 Overview 이벤트 발행 횟수 기본값
+	OVERVIEW_MAX_EMITS_PER_SEC = 10
+)
+
+// emitThrottle는 Agent 별 대기 중인 최신 Overview 이벤트 페이로드입니다.
+type emitThrottle struct {
+	mu      sync.Mutex
+	rate    int
+	pending map[string]map[string]any
+	order   []string // 대기 중인 Agent 의 최초 도착 순서
+	// 발행 속도 변경 신호 (emit 루프가 주기를 다시 계산)
+	changed chan struct{}
+}
+
+// newEmitThrottle는 rate(Agent 당 초당 최대 발행 수)로 emitThrottle 를 생성합니다.
+func newEmitThrottle(rate int) *emitThrottle {
+	return &emitThrottle{
+		rate:    rate,
+		pending: make(map[string]map[string]any),
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// interval 발행 주기를 반환합니다. 제한이 없으면 0 입니다.
+func (t *emitThrottle) interval() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rate <= 0 {
+		return 0
+	}
+	return time.Second / time.Duration(t.rate)
+}
+
+// put Agent 의 대기 페이로드를 최신 값으로 교체합니다. 제한이 없으면 false 를 반환하여 즉시 발행하게 합니다.
+func (t *emitThrottle) put(agentId string, payload map[string]any) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rate <= 0 {
+		return false
+	}
+	if _, ok := t.pending[agentId]; !ok {
+		t.order = append(t.order, agentId)
+	}
+	t.pending[agentId] = payload
+	return true
+}
+
+// drain 대기 중인 페이로드를 도착 순서대로 모두 꺼냅니다.
+func (t *emitThrottle) drain() []map[string]any {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	payloads := make([]map[string]any, 0, len(t.order))
+	for _, agentId := range t.order {
+		payloads = append(payloads, t.pending[agentId])
+		delete(t.pending, agentId)
+	}
+	t.order = t.order[:0]
+	return payloads
+}
+
+// setRate 발행 속도를 변경하고 emit 루프에 알립니다.
+func (t *emitThrottle) setRate(rate int) {
+	t.mu.Lock()
+	t.rate = rate
+	t.mu.Unlock()
+	select {
+	case t.changed <- struct{}{}:
+	default:
+	}
+}
+
+// SetOverviewEmitRate Agent 당 초당 최대 Overview 이벤트 발행 횟수를 설정합니다. 0 이하이면 제한하지 않습니다.
+func (a *App) SetOverviewEmitRate(perSecond int) {
+	a.emitThrottle.setRate(perSecond)
+}
+
+// emitOverview Overview 프레임 이벤트를 발행하거나, 속도 제한 중이면 다음 주기까지 보관합니다.
+func (a *App) emitOverview(agentId string, payload map[string]any) {
+	if a.emitThrottle.put(agentId, payload) {
+		return
+	}
+	a.emit(EVENT_OVERVIEW_FRAME, payload)
+}
+
+// flushOverview 보관 중인 Overview 이벤트를 발행합니다.
+func (a *App) flushOverview() {
+	for _, payload := range a.emitThrottle.drain() {
+		a.emit(EVENT_OVERVIEW_FRAME, payload)
+	}
+}
+
+// overviewEmitLoop 발행 주기마다 보관 중인 Overview 이벤트를 내보냅니다.
+func (a *App) overviewEmitLoop() {
+	for {
+		interval := a.emitThrottle.interval()
+		if interval <= 0 {
+			// 제한 해제 직전까지 쌓인 이벤트를 내보내고 속도 변경을 기다림
+			a.flushOverview()
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-a.emitThrottle.changed:
+			}
+			continue
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-a.ctx.Done():
+			timer.Stop()
+			return
+		case <-a.emitThrottle.changed:
+			timer.Stop()
+		case <-timer.C:
+			a.flushOverview()
+		}
+	}
+}
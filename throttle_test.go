@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"admin/internal/server/servertest"
+)
+
+// 속도 제한 테스트에서 보내는 프레임 수와 설정하는 초당 발행 수
+const (
+	THROTTLE_TEST_FRAMES = 100
+	THROTTLE_TEST_RATE   = 5
+)
+
+func TestOverviewEmitRateCapped(t *testing.T) {
+	h := servertest.Start(nil)
+	defer h.Close()
+	app, rec := startTestApp(t, h)
+	app.SetOverviewEmitRate(THROTTLE_TEST_RATE)
+	waitConnected(t, app)
+	waitFor(t, "Overview 구독 등록", nil, func() bool { return h.Service.Stats().OverviewSubscribers == 1 })
+
+	started := time.Now()
+	for i := range THROTTLE_TEST_FRAMES {
+		pushFrame(h, "agent-1", fmt.Sprintf("frame-%d", i))
+		time.Sleep(time.Millisecond)
+	}
+	newest := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("frame-%d", THROTTLE_TEST_FRAMES-1)))
+
+	// 캐시는 발행 주기와 무관하게 받은 즉시 최신 프레임으로 갱신
+	waitFor(t, "캐시에 최신 프레임 반영", nil, func() bool {
+		snap, ok := app.GetLatestFrame("agent-1")
+		return ok && snap.ImageBase == newest
+	})
+	// 보관된 최신 프레임이 다음 발행 주기에 나감
+	waitFor(t, "최신 프레임 overviewFrame 발행", nil, func() bool {
+		events := rec.named(EVENT_OVERVIEW_FRAME)
+		if len(events) == 0 {
+			return false
+		}
+		payload, _ := events[len(events)-1].(map[string]any)
+		return payload["imageBase64"] == newest
+	})
+
+	elapsed := time.Since(started)
+	limit := int(elapsed/(time.Second/THROTTLE_TEST_RATE)) + 2
+	if got := len(rec.named(EVENT_OVERVIEW_FRAME)); got > limit || got >= THROTTLE_TEST_FRAMES {
+		t.Fatalf("%s 동안 overviewFrame 발행 = %d, want <= %d", elapsed, got, limit)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+// gRPC 연결 TLS 설정
+// - CA 인증서 경로 지정 시 해당 CA 로 서버 인증서 검증, 미지정 시 시스템 루트 CA 사용
+// - 클라이언트 인증서/키 지정 시 mTLS
+// - 평문(insecure) 연결은 Insecure 를 명시적으로 켠 경우에만 사용
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// TLS 설정 환경변수 이름
+	ENV_GRPC_TLS_CA_FILE     = "ADMIN_GRPC_TLS_CA"
+	ENV_GRPC_TLS_CERT_FILE   = "ADMIN_GRPC_TLS_CERT"
+	ENV_GRPC_TLS_KEY_FILE    = "ADMIN_GRPC_TLS_KEY"
+	ENV_GRPC_TLS_SERVER_NAME = "ADMIN_GRPC_TLS_SERVER_NAME"
+	ENV_GRPC_INSECURE        = "ADMIN_GRPC_INSECURE"
+)
+
+// tlsSettings는 gRPC 연결 보안 설정입니다.
+type tlsSettings struct {
+	CAFile     string `json:"caFile"`
+	CertFile   string `json:"certFile"`
+	KeyFile    string `json:"keyFile"`
+	ServerName string `json:"serverName"`
+	Insecure   bool   `json:"insecure"`
+}
+
+// tlsSettingsFromEnv 환경변수에서 TLS 설정을 읽습니다.
+func tlsSettingsFromEnv() tlsSettings {
+	cfg := tlsSettings{
+		CAFile:     os.Getenv(ENV_GRPC_TLS_CA_FILE),
+		CertFile:   os.Getenv(ENV_GRPC_TLS_CERT_FILE),
+		KeyFile:    os.Getenv(ENV_GRPC_TLS_KEY_FILE),
+		ServerName: os.Getenv(ENV_GRPC_TLS_SERVER_NAME),
+	}
+	if v := os.Getenv(ENV_GRPC_INSECURE); v != "" {
+		insecureOn, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Printf("[Admin][BOOT] %s 값 무시: %v", ENV_GRPC_INSECURE, err)
+		}
+		cfg.Insecure = insecureOn
+	}
+	return cfg
+}
+
+// dialOption 설정에 맞는 전송 보안 DialOption 을 생성합니다.
+func (c tlsSettings) dialOption() (grpc.DialOption, error) {
+	if c.Insecure {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: c.ServerName}
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		if c.CertFile == "" || c.KeyFile == "" {
+			return nil, errors.New("client cert and key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client key pair: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
+}
+
+// SetTLSConfig 연결 보안 설정을 변경하고 새 설정으로 재연결합니다.
+// 인증서 파일을 읽을 수 없으면 에러를 반환하고 기존 설정을 유지합니다.
+func (a *App) SetTLSConfig(cfg tlsSettings) error {
+	if _, err := cfg.dialOption(); err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.tls = cfg
+	a.mu.Unlock()
+
+	log.Printf("[Admin][BOOT] TLS 설정 변경 (insecure=%v)", cfg.Insecure)
+	a.requestReconnect()
+	return nil
+}
+
+// tlsConfig 현재 연결 보안 설정을 반환합니다.
+func (a *App) tlsConfig() tlsSettings {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tls
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"admin/internal/server"
+	"admin/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// selfSignedCert localhost/127.0.0.1 용 자체 서명 인증서와 PEM 을 생성합니다.
+func selfSignedCert(t *testing.T) (tls.Certificate, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, certPEM
+}
+
+// startTLSServer TLS 로 AdminService 를 제공하는 TCP 서버를 시작하고 주소를 반환합니다.
+func startTLSServer(t *testing.T, cert tls.Certificate) (*server.AdminService, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := server.NewAdminService()
+	srv := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	proto.RegisterAdminServiceServer(srv, svc)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+		defer cancel()
+		_ = svc.Shutdown(ctx)
+		srv.Stop()
+	})
+	return svc, lis.Addr().String()
+}
+
+func TestTLSDialWithCA(t *testing.T) {
+	cert, certPEM := selfSignedCert(t)
+	svc, addr := startTLSServer(t, cert)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ENV_GRPC_SERVER_ADDRESS, addr)
+	t.Setenv(ENV_GRPC_TLS_CA_FILE, caFile)
+
+	app, rec := newTestApp()
+	app.startup(context.Background())
+	defer app.shutdown(context.Background())
+
+	waitFor(t, "TLS 연결 후 Overview 구독", nil, func() bool { return svc.Stats().OverviewSubscribers == 1 })
+	waitFor(t, "TLS 경유 overviewFrame 이벤트", func() {
+		svc.HandleIncomingFrame(&proto.FrameData{AgentId: "agent-1", ImageData: []byte("img"), Timestamp: time.Now().UnixMilli()})
+	}, func() bool { return len(rec.named(EVENT_OVERVIEW_FRAME)) > 0 })
+}
+
+func TestTLSDialRejectsUnknownCA(t *testing.T) {
+	cert, _ := selfSignedCert(t)
+	_, addr := startTLSServer(t, cert)
+
+	// CA 를 지정하지 않으면 시스템 루트로 검증하므로 자체 서명 인증서를 거부해야 함
+	creds, err := tlsSettings{}.dialOption()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.NewClient(addr, creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), TEST_WAIT_TIMEOUT)
+	defer cancel()
+	if _, err := proto.NewAdminServiceClient(conn).HealthCheck(ctx, &proto.HealthCheckRequest{}); err == nil {
+		t.Fatal("신뢰하지 않는 인증서로 연결 성공")
+	}
+}